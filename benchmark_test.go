@@ -5,7 +5,13 @@
 package fastparse_test
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"math/rand"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/mshafiee/fastparse"
@@ -145,6 +151,71 @@ func BenchmarkQuoteToASCII_Strconv(b *testing.B) {
 	}
 }
 
+// quoteBenchSizes are the lengths of the sized Quote benchmarks, whose
+// input is prose with a newline to escape every 45 bytes.
+var quoteBenchSizes = []int{8, 16, 32, 64, 256, 1024, 4096}
+
+func quoteBenchString(n int) string {
+	const line = "The quick brown fox jumps over the lazy dog.\n"
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = line[i%len(line)]
+	}
+	return string(b)
+}
+
+func BenchmarkAppendQuoteSized_Fastparse(b *testing.B) {
+	for _, n := range quoteBenchSizes {
+		s := quoteBenchString(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			buf := make([]byte, 0, 2*n)
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				buf = fastparse.AppendQuote(buf[:0], s)
+			}
+		})
+	}
+}
+
+func BenchmarkAppendQuoteSized_Strconv(b *testing.B) {
+	for _, n := range quoteBenchSizes {
+		s := quoteBenchString(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			buf := make([]byte, 0, 2*n)
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				buf = strconv.AppendQuote(buf[:0], s)
+			}
+		})
+	}
+}
+
+func BenchmarkQuoteToASCIISized_Fastparse(b *testing.B) {
+	for _, n := range quoteBenchSizes {
+		s := quoteBenchString(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = fastparse.QuoteToASCII(s)
+			}
+		})
+	}
+}
+
+func BenchmarkQuoteToASCIISized_Strconv(b *testing.B) {
+	for _, n := range quoteBenchSizes {
+		s := quoteBenchString(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = strconv.QuoteToASCII(s)
+			}
+		})
+	}
+}
+
 // Unquote benchmarks
 
 func BenchmarkUnquote_Fastparse(b *testing.B) {
@@ -163,6 +234,62 @@ func BenchmarkUnquote_Strconv(b *testing.B) {
 	}
 }
 
+var unquoteBenchStrings = []struct {
+	name string
+	s    string
+}{
+	{"Plain", `"The quick brown fox jumps over the lazy dog, again and again."`},
+	{"Escaped", `"line one\n\t\"quoted\" \x41\u00e9\U0001F680 and the rest of the line"`},
+}
+
+func BenchmarkAppendUnquote_Fastparse(b *testing.B) {
+	for _, bs := range unquoteBenchStrings {
+		b.Run(bs.name, func(b *testing.B) {
+			buf := make([]byte, 0, 256)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf, _ = fastparse.AppendUnquote(buf[:0], bs.s)
+			}
+		})
+	}
+}
+
+func BenchmarkUnquoteBytes_Fastparse(b *testing.B) {
+	for _, bs := range unquoteBenchStrings {
+		b.Run(bs.name, func(b *testing.B) {
+			buf := []byte(bs.s)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				copy(buf, bs.s)
+				_, _ = fastparse.UnquoteBytes(buf)
+			}
+		})
+	}
+}
+
+func BenchmarkAppendUnquote_Strconv(b *testing.B) {
+	for _, bs := range unquoteBenchStrings {
+		b.Run(bs.name, func(b *testing.B) {
+			buf := make([]byte, 0, 256)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				v, _ := strconv.Unquote(bs.s)
+				buf = append(buf[:0], v...)
+			}
+		})
+	}
+}
+
+func BenchmarkAppendQuoteTruncated_Fastparse(b *testing.B) {
+	s := strings.Repeat("a 10 MB payload \"quoted\" ", 1<<16)
+	buf := make([]byte, 0, 256)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = fastparse.AppendQuoteTruncated(buf[:0], s, 200, fastparse.QuoteModeJSON)
+	}
+}
+
 // Complex number benchmarks
 
 func BenchmarkFormatComplex_Fastparse(b *testing.B) {
@@ -255,6 +382,224 @@ func BenchmarkAppendFloat_Strconv(b *testing.B) {
 	}
 }
 
+// Shortest float64 digit generation benchmarks
+
+// shortestInputs are the shortest-formatting workloads: random bit
+// patterns, integral values and short decimals as found in JSON.
+var shortestInputs = func() []struct {
+	name string
+	vals []float64
+} {
+	r := rand.New(rand.NewSource(1))
+	random := make([]float64, 1024)
+	integers := make([]float64, 1024)
+	decimals := make([]float64, 1024)
+	for i := range random {
+		for {
+			random[i] = math.Float64frombits(r.Uint64())
+			if !math.IsNaN(random[i]) && !math.IsInf(random[i], 0) {
+				break
+			}
+		}
+		random[i] = math.Abs(random[i])
+		integers[i] = float64(r.Int63n(1e9))
+		decimals[i] = float64(r.Intn(1e6)) / 100
+	}
+	return []struct {
+		name string
+		vals []float64
+	}{
+		{"RandomBits", random},
+		{"Integers", integers},
+		{"ShortDecimals", decimals},
+	}
+}()
+
+func benchmarkShortest(b *testing.B, shortest func([]byte, float64) ([]byte, int)) {
+	for _, in := range shortestInputs {
+		b.Run(in.name, func(b *testing.B) {
+			var buf [32]byte
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				shortest(buf[:], in.vals[i%len(in.vals)])
+			}
+		})
+	}
+}
+
+func BenchmarkShortest_Ryu(b *testing.B) {
+	benchmarkShortest(b, fastparse.ShortestRyu)
+}
+
+func BenchmarkShortest_Schubfach(b *testing.B) {
+	benchmarkShortest(b, fastparse.ShortestSchubfach)
+}
+
+func BenchmarkAppendFloatShortest_Fastparse(b *testing.B) {
+	for _, in := range shortestInputs {
+		b.Run(in.name, func(b *testing.B) {
+			buf := make([]byte, 0, 32)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf = fastparse.AppendFloat(buf[:0], in.vals[i%len(in.vals)], 'g', -1, 64)
+			}
+		})
+	}
+}
+
+func BenchmarkAppendFloatShortest_Strconv(b *testing.B) {
+	for _, in := range shortestInputs {
+		b.Run(in.name, func(b *testing.B) {
+			buf := make([]byte, 0, 32)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf = strconv.AppendFloat(buf[:0], in.vals[i%len(in.vals)], 'g', -1, 64)
+			}
+		})
+	}
+}
+
+// JSON string benchmarks
+
+var jsonBenchStrings = []struct {
+	name string
+	s    string
+}{
+	{"ASCII", "The quick brown fox jumps over the lazy dog, again and again."},
+	{"Escaped", "line one\n\t\"quoted\" <b>bold</b> & \\backslash\\ \u00e9\u4e16"},
+}
+
+func BenchmarkAppendQuoteJSON_Fastparse(b *testing.B) {
+	for _, bs := range jsonBenchStrings {
+		b.Run(bs.name, func(b *testing.B) {
+			buf := make([]byte, 0, 256)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf = fastparse.AppendQuoteJSON(buf[:0], bs.s, fastparse.JSONEscapeHTML)
+			}
+		})
+	}
+}
+
+func BenchmarkAppendQuoteJSON_EncodingJSON(b *testing.B) {
+	for _, bs := range jsonBenchStrings {
+		b.Run(bs.name, func(b *testing.B) {
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf.Reset()
+				_ = enc.Encode(bs.s)
+			}
+		})
+	}
+}
+
+func BenchmarkUnquoteJSON_Fastparse(b *testing.B) {
+	for _, bs := range jsonBenchStrings {
+		q := fastparse.QuoteJSON(bs.s, fastparse.JSONEscapeHTML)
+		b.Run(bs.name, func(b *testing.B) {
+			buf := make([]byte, 0, 256)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf, _ = fastparse.AppendUnquoteJSON(buf[:0], q)
+			}
+		})
+	}
+}
+
+func BenchmarkUnquoteJSON_EncodingJSON(b *testing.B) {
+	for _, bs := range jsonBenchStrings {
+		q := []byte(fastparse.QuoteJSON(bs.s, fastparse.JSONEscapeHTML))
+		b.Run(bs.name, func(b *testing.B) {
+			var s string
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = json.Unmarshal(q, &s)
+			}
+		})
+	}
+}
+
+// CSV benchmarks
+
+// csvBenchInput returns 1000 records of an id, a quoted name, a price and
+// a flag.
+func csvBenchInput() []byte {
+	var buf []byte
+	for i := 0; i < 1000; i++ {
+		buf = strconv.AppendInt(buf, int64(i), 10)
+		buf = append(buf, `,"Widget, size ""L""",`...)
+		buf = strconv.AppendFloat(buf, float64(i)*1.25, 'g', -1, 64)
+		buf = append(buf, ",true\n"...)
+	}
+	return buf
+}
+
+func BenchmarkCSVRead_Fastparse(b *testing.B) {
+	data := csvBenchInput()
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r := fastparse.NewCSVReader(bytes.NewReader(data))
+		for {
+			if _, err := r.Read(); err != nil {
+				break
+			}
+			_, _ = r.Int64(0)
+			_, _ = r.Float64(2)
+			_, _ = r.Bool(3)
+		}
+	}
+}
+
+func BenchmarkCSVRead_EncodingCSV(b *testing.B) {
+	data := csvBenchInput()
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r := csv.NewReader(bytes.NewReader(data))
+		r.ReuseRecord = true
+		for {
+			rec, err := r.Read()
+			if err != nil {
+				break
+			}
+			_, _ = strconv.ParseInt(rec[0], 10, 64)
+			_, _ = strconv.ParseFloat(rec[2], 64)
+			_, _ = strconv.ParseBool(rec[3])
+		}
+	}
+}
+
+func BenchmarkAppendCSVField_Fastparse(b *testing.B) {
+	for _, bs := range jsonBenchStrings {
+		b.Run(bs.name, func(b *testing.B) {
+			buf := make([]byte, 0, 256)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf = fastparse.AppendCSVField(buf[:0], bs.s, ',')
+			}
+		})
+	}
+}
+
+func BenchmarkAppendCSVField_EncodingCSV(b *testing.B) {
+	for _, bs := range jsonBenchStrings {
+		b.Run(bs.name, func(b *testing.B) {
+			var buf bytes.Buffer
+			w := csv.NewWriter(&buf)
+			rec := []string{bs.s}
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf.Reset()
+				_ = w.Write(rec)
+				w.Flush()
+			}
+		})
+	}
+}
+
 // IsPrint/IsGraphic benchmarks
 
 func BenchmarkIsPrint_Fastparse(b *testing.B) {
@@ -288,3 +633,25 @@ func BenchmarkIsGraphic_Strconv(b *testing.B) {
 		_ = strconv.IsGraphic(r)
 	}
 }
+
+var validatePrintInput = strings.Repeat("request_id=42 user=\"Jane Doe\" path=/api/v1/items ", 4) + "café"
+
+func BenchmarkValidatePrint_Fastparse(b *testing.B) {
+	b.SetBytes(int64(len(validatePrintInput)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = fastparse.ValidatePrint(validatePrintInput)
+	}
+}
+
+func BenchmarkValidatePrint_RuneLoop(b *testing.B) {
+	b.SetBytes(int64(len(validatePrintInput)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, r := range validatePrintInput {
+			if !strconv.IsPrint(r) {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"io"
+	"math"
+	"sync"
+)
+
+// A Buffer is a byte buffer for building text with the fastparse
+// formatters, in place of a []byte threaded through AppendInt,
+// AppendFloat and AppendQuote calls. The zero value is an empty buffer
+// ready to use.
+//
+// Before each typed write, Buffer grows to fit the longest text the value
+// could format to, doubling its capacity so that growth is amortized.
+// Integers, fixed-point numbers and bools are then stored directly into
+// the reserved capacity without bounds checks.
+type Buffer struct {
+	buf []byte
+}
+
+// NewBuffer returns a Buffer whose initial contents are buf. The Buffer
+// takes ownership of buf; its capacity is used before any allocation.
+func NewBuffer(buf []byte) *Buffer {
+	return &Buffer{buf}
+}
+
+// Bytes returns the contents of b. The slice is valid only until the
+// next write to or Reset of b.
+func (b *Buffer) Bytes() []byte { return b.buf }
+
+// String returns a copy of the contents of b as a string.
+func (b *Buffer) String() string { return string(b.buf) }
+
+// Len returns the number of bytes in b.
+func (b *Buffer) Len() int { return len(b.buf) }
+
+// Cap returns the capacity of b's underlying byte slice.
+func (b *Buffer) Cap() int { return cap(b.buf) }
+
+// Reset empties b, keeping its capacity for reuse.
+func (b *Buffer) Reset() { b.buf = b.buf[:0] }
+
+// Truncate discards all but the first n bytes of b. It panics if n is
+// negative or greater than b.Len().
+func (b *Buffer) Truncate(n int) { b.buf = b.buf[:n] }
+
+// Grow grows b's capacity, if necessary, to guarantee space for another
+// n bytes. It panics if n is negative.
+func (b *Buffer) Grow(n int) {
+	if n < 0 {
+		panic("fastparse.Buffer.Grow: negative count")
+	}
+	b.grow(n)
+}
+
+// AvailableBuffer returns an empty slice with b's unused capacity, for
+// an Append function to fill before its result is passed to Write:
+//
+//	b.Write(fastparse.AppendUint(b.AvailableBuffer(), u, 16))
+func (b *Buffer) AvailableBuffer() []byte { return b.buf[len(b.buf):] }
+
+// grow makes room in b for n more bytes.
+func (b *Buffer) grow(n int) {
+	if cap(b.buf)-len(b.buf) >= n {
+		return
+	}
+	c := 2*cap(b.buf) + n
+	if c < 64 {
+		c = 64
+	}
+	buf := make([]byte, len(b.buf), c)
+	copy(buf, b.buf)
+	b.buf = buf
+}
+
+// Write appends p to b. It implements [io.Writer] and always returns
+// len(p), nil.
+func (b *Buffer) Write(p []byte) (int, error) {
+	b.buf = append(b.buf, p...)
+	return len(p), nil
+}
+
+// WriteString appends s to b. It implements [io.StringWriter] and always
+// returns len(s), nil.
+func (b *Buffer) WriteString(s string) (int, error) {
+	b.buf = append(b.buf, s...)
+	return len(s), nil
+}
+
+// WriteByte appends c to b. It implements [io.ByteWriter] and always
+// returns nil.
+func (b *Buffer) WriteByte(c byte) error {
+	b.buf = append(b.buf, c)
+	return nil
+}
+
+// WriteTo writes the contents of b to w and removes what w accepted. It
+// implements [io.WriterTo].
+func (b *Buffer) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(b.buf)
+	if n < 0 || n > len(b.buf) {
+		panic("fastparse.Buffer.WriteTo: invalid Write count")
+	}
+	if n < len(b.buf) && err == nil {
+		err = io.ErrShortWrite
+	}
+	b.buf = b.buf[:copy(b.buf, b.buf[n:])]
+	return int64(n), err
+}
+
+// WriteInt appends the base 10 text of i to b, as [AppendInt] does.
+func (b *Buffer) WriteInt(i int64) {
+	b.grow(20) // "-9223372036854775808"
+	buf, n := b.buf[:cap(b.buf)], len(b.buf)
+	u := uint64(i)
+	if i < 0 {
+		writeByteUnchecked(buf, n, '-')
+		n++
+		u = -u
+	}
+	nd := digitCount(u)
+	putDecimal(buf, n, u, nd)
+	b.buf = buf[:n+nd]
+}
+
+// WriteUint appends the base 10 text of u to b, as [AppendUint] does.
+func (b *Buffer) WriteUint(u uint64) {
+	b.grow(20) // "18446744073709551615"
+	buf, n := b.buf[:cap(b.buf)], len(b.buf)
+	nd := digitCount(u)
+	putDecimal(buf, n, u, nd)
+	b.buf = buf[:n+nd]
+}
+
+// WriteFloat appends the float64 f to b, formatted by [AppendFloat] with
+// fmt and prec.
+func (b *Buffer) WriteFloat(f float64, fmt byte, prec int) {
+	b.grow(maxFloatLen(f, fmt, prec))
+	b.buf = AppendFloat(b.buf, f, fmt, prec, 64)
+}
+
+// WriteBool appends "true" or "false" to b, according to v.
+func (b *Buffer) WriteBool(v bool) {
+	b.grow(5)
+	buf, n := b.buf[:cap(b.buf)], len(b.buf)
+	if v {
+		writeUint32(buf, n, readUint32("true", 0))
+		b.buf = buf[:n+4]
+		return
+	}
+	writeUint32(buf, n, readUint32("fals", 0))
+	writeByteUnchecked(buf, n+4, 'e')
+	b.buf = buf[:n+5]
+}
+
+// WriteQuoted appends s to b as a double-quoted Go string literal, as
+// [AppendQuote] does.
+func (b *Buffer) WriteQuoted(s string) {
+	// Room for s without escapes; escapes grow b as they need.
+	b.grow(len(s) + 2)
+	b.buf = AppendQuote(b.buf, s)
+}
+
+// WriteJSONString appends s to b as a JSON string literal, as
+// [AppendQuoteJSON] does with flags.
+func (b *Buffer) WriteJSONString(s string, flags JSONFlags) {
+	b.grow(len(s) + 2)
+	b.buf = AppendQuoteJSON(b.buf, s, flags)
+}
+
+// WriteFixed appends v × 10^-scale to b in fixed-point notation with
+// exactly scale digits after the decimal point, such as "-0.05" for v -5
+// and scale 2. It suits amounts kept as scaled integers, and numbers
+// split by [Number.Decimal], whose exponent is -scale. A negative scale
+// appends -scale zeros to v and no decimal point. It panics if the text
+// would be longer than the largest int.
+func (b *Buffer) WriteFixed(v int64, scale int) {
+	if scale > math.MaxInt-21 || scale < 20-math.MaxInt {
+		panic("fastparse.Buffer.WriteFixed: scale out of range")
+	}
+	u := uint64(v)
+	if v < 0 {
+		u = -u
+	}
+	if scale <= 0 {
+		zeros := -scale
+		if u == 0 {
+			zeros = 0
+		}
+		b.grow(20 + zeros)
+		buf, n := b.buf[:cap(b.buf)], len(b.buf)
+		if v < 0 {
+			writeByteUnchecked(buf, n, '-')
+			n++
+		}
+		nd := digitCount(u)
+		putDecimal(buf, n, u, nd)
+		n += nd
+		for i := 0; i < zeros; i++ {
+			writeByteUnchecked(buf, n+i, '0')
+		}
+		n += zeros
+		b.buf = buf[:n]
+		return
+	}
+
+	// The integer part has at most 19 digits, as |v| ≤ 2^63 < 10^19.
+	b.grow(21 + scale)
+	buf, n := b.buf[:cap(b.buf)], len(b.buf)
+	if v < 0 {
+		writeByteUnchecked(buf, n, '-')
+		n++
+	}
+	hi, lo := uint64(0), u
+	if scale < len(pow10Table) {
+		p := pow10Table[scale]
+		hi, lo = u/p, u%p
+	}
+	nd := digitCount(hi)
+	putDecimal(buf, n, hi, nd)
+	n += nd
+	writeByteUnchecked(buf, n, '.')
+	putDecimal(buf, n+1, lo, scale)
+	b.buf = buf[:n+1+scale]
+}
+
+// putDecimal writes the last nd decimal digits of u to buf[i:i+nd],
+// padding with zeros on the left, two digits at a time.
+// UNSAFE: Caller must ensure i+nd <= len(buf).
+func putDecimal(buf []byte, i int, u uint64, nd int) {
+	j := i + nd
+	for j-i >= 2 {
+		q := u / 100
+		j -= 2
+		writeUint16(buf, j, readUint16(smallsString, int(u-q*100)*2))
+		u = q
+	}
+	if j > i {
+		writeByteUnchecked(buf, i, byte('0'+u%10))
+	}
+}
+
+// maxFloatLen returns an upper bound on the length of f formatted by
+// AppendFloat with fmt and prec as a float64.
+func maxFloatLen(f float64, fmt byte, prec int) int {
+	if fmt != 'f' {
+		// A sign, 17 digits or prec+1, a point, and an exponent such
+		// as "e-308" or "p-1074", or a "0x" prefix and "p+1023". The
+		// 'f' form 'g' picks is no longer than its 'e' form plus four
+		// leading zeros.
+		return 25 + max(prec, 0)
+	}
+	// |f| < 2^e: the integer part has at most 1 + e·log10(2) digits, and
+	// the shortest fraction of a number below 1 at most 17 digits after
+	// -e·log10(2) leading zeros.
+	_, e := math.Frexp(f)
+	n := 3 // sign, point, and a digit of slack
+	if e > 0 {
+		n += 1 + e*30103/100000
+	} else {
+		n++
+	}
+	if prec >= 0 {
+		return n + prec
+	}
+	if e < 0 {
+		n += 1 - e*30103/100000
+	}
+	return n + 17
+}
+
+// maxPooledBuffer is the largest capacity PutBuffer keeps.
+const maxPooledBuffer = 64 << 10
+
+var bufferPool = sync.Pool{
+	New: func() any { return new(Buffer) },
+}
+
+// GetBuffer returns an empty Buffer from a pool shared by the package.
+// Return it with [PutBuffer] once its contents are no longer needed.
+func GetBuffer() *Buffer {
+	return bufferPool.Get().(*Buffer)
+}
+
+// PutBuffer resets b and returns it to the pool of [GetBuffer]. Buffers
+// that have grown beyond 64 KiB are dropped instead, so that one large
+// message does not pin its memory. b must not be used after PutBuffer.
+func PutBuffer(b *Buffer) {
+	if cap(b.buf) > maxPooledBuffer {
+		return
+	}
+	b.Reset()
+	bufferPool.Put(b)
+}
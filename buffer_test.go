@@ -0,0 +1,224 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var _ interface {
+	io.Writer
+	io.StringWriter
+	io.ByteWriter
+	io.WriterTo
+} = (*Buffer)(nil)
+
+func TestBuffer(t *testing.T) {
+	var b Buffer
+	var want []byte
+	for _, i := range []int64{0, 7, -7, 10, 99, -100, 12345, math.MaxInt64, math.MinInt64} {
+		b.WriteInt(i)
+		want = AppendInt(want, i, 10)
+		b.WriteByte(' ')
+		want = append(want, ' ')
+	}
+	for _, u := range []uint64{0, 9, 100, 1<<32 + 1, math.MaxUint64} {
+		b.WriteUint(u)
+		want = AppendUint(want, u, 10)
+	}
+	b.WriteBool(true)
+	b.WriteBool(false)
+	want = append(want, "truefalse"...)
+	b.WriteFloat(0.1, 'g', -1)
+	b.WriteFloat(-1.5e-300, 'e', 3)
+	want = AppendFloat(want, 0.1, 'g', -1, 64)
+	want = AppendFloat(want, -1.5e-300, 'e', 3, 64)
+	b.WriteQuoted("tab\there")
+	b.WriteJSONString("<a> ", JSONEscapeHTML)
+	want = AppendQuote(want, "tab\there")
+	want = AppendQuoteJSON(want, "<a> ", JSONEscapeHTML)
+	b.WriteString("end")
+	b.Write([]byte("!"))
+	want = append(want, "end!"...)
+	if got := b.String(); got != string(want) {
+		t.Errorf("Buffer = %s\nwant %s", got, want)
+	}
+
+	b.Reset()
+	b.Write(AppendUint(b.AvailableBuffer(), 255, 16))
+	if b.String() != "ff" {
+		t.Errorf("AvailableBuffer write = %q", b.String())
+	}
+	b.Truncate(1)
+	if b.String() != "f" || b.Len() != 1 {
+		t.Errorf("Truncate(1) = %q", b.String())
+	}
+}
+
+func TestBufferWriteFixed(t *testing.T) {
+	tests := []struct {
+		v     int64
+		scale int
+		want  string
+	}{
+		{0, 0, "0"},
+		{0, 2, "0.00"},
+		{0, -3, "0"},
+		{12345, 2, "123.45"},
+		{-5, 2, "-0.05"},
+		{5, 1, "0.5"},
+		{-1, 0, "-1"},
+		{12, -3, "12000"},
+		{-12, -1, "-120"},
+		{100, 2, "1.00"},
+		{math.MaxInt64, 18, "9.223372036854775807"},
+		{math.MinInt64, 19, "-0.9223372036854775808"},
+		{math.MinInt64, 0, "-9223372036854775808"},
+		{3, 25, "0.0000000000000000000000003"},
+	}
+	for _, test := range tests {
+		var b Buffer
+		b.WriteFixed(test.v, test.scale)
+		if got := b.String(); got != test.want {
+			t.Errorf("WriteFixed(%d, %d) = %q, want %q", test.v, test.scale, got, test.want)
+		}
+	}
+	for _, s := range []string{"1.50", "-0.05", "12.345e3", "1e-7", "0.000"} {
+		coef, exp, err := Number(s).Decimal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b Buffer
+		b.WriteFixed(coef, -exp)
+		want, _ := ParseFloat(s, 64)
+		if got, _ := ParseFloat(b.String(), 64); got != want {
+			t.Errorf("WriteFixed of Number(%q).Decimal() = %s", s, b.String())
+		}
+	}
+}
+
+func TestBufferWriteFixedScaleRange(t *testing.T) {
+	for _, scale := range []int{math.MaxInt, math.MaxInt - 10, math.MinInt, math.MinInt + 10, -math.MaxInt} {
+		b := NewBuffer(make([]byte, 0, 64))
+		b.WriteString("x")
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("WriteFixed(1, %d) did not panic", scale)
+				}
+			}()
+			b.WriteFixed(1, scale)
+		}()
+		if b.String() != "x" {
+			t.Errorf("WriteFixed(1, %d) left %q", scale, b.String())
+		}
+	}
+	// Zero needs no zeros, however negative the scale.
+	var b Buffer
+	b.WriteFixed(0, 1-math.MaxInt/2)
+	if b.String() != "0" {
+		t.Errorf("WriteFixed(0, %d) = %q, want %q", 1-math.MaxInt/2, b.String(), "0")
+	}
+}
+
+func TestMaxFloatLen(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	floats := []float64{0, math.Copysign(0, -1), math.Inf(-1), math.NaN(), math.MaxFloat64, -math.MaxFloat64,
+		math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64, 1e21, 1e-5, -123456.789}
+	for i := 0; i < 1000; i++ {
+		floats = append(floats, math.Float64frombits(r.Uint64()))
+	}
+	for _, f := range floats {
+		for _, fmt := range []byte("beEfgGxX") {
+			for _, prec := range []int{-1, 0, 1, 5, 17, 40} {
+				got := len(AppendFloat(nil, f, fmt, prec, 64))
+				if bound := maxFloatLen(f, fmt, prec); got > bound {
+					t.Errorf("AppendFloat(%v, %c, %d) has %d bytes, more than maxFloatLen %d", f, fmt, prec, got, bound)
+				}
+			}
+		}
+	}
+}
+
+type shortWriter struct {
+	bytes.Buffer
+	max int
+}
+
+func (w *shortWriter) Write(p []byte) (int, error) {
+	if len(p) > w.max {
+		p = p[:w.max]
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestBufferWriteTo(t *testing.T) {
+	b := NewBuffer(make([]byte, 0, 8))
+	b.WriteString("hello, world")
+	var w bytes.Buffer
+	if n, err := b.WriteTo(&w); n != 12 || err != nil || w.String() != "hello, world" || b.Len() != 0 {
+		t.Errorf("WriteTo = %d, %v; wrote %q, left %q", n, err, w.String(), b.String())
+	}
+
+	b.WriteString("hello, world")
+	sw := &shortWriter{max: 5}
+	if n, err := b.WriteTo(sw); n != 5 || err != io.ErrShortWrite || b.String() != ", world" {
+		t.Errorf("short WriteTo = %d, %v; left %q", n, err, b.String())
+	}
+}
+
+func TestBufferPool(t *testing.T) {
+	b := GetBuffer()
+	if b.Len() != 0 {
+		t.Fatalf("GetBuffer returned %d bytes", b.Len())
+	}
+	b.WriteInt(42)
+	PutBuffer(b)
+	if b.Len() != 0 {
+		t.Errorf("PutBuffer did not reset the buffer")
+	}
+	big := GetBuffer()
+	big.Grow(maxPooledBuffer + 1)
+	PutBuffer(big)
+	if GetBuffer() == big {
+		t.Errorf("PutBuffer kept an oversized buffer")
+	}
+}
+
+func TestBufferAllocs(t *testing.T) {
+	var b Buffer
+	b.Grow(512)
+	allocs := testing.AllocsPerRun(100, func() {
+		b.Reset()
+		b.WriteInt(-123456789)
+		b.WriteUint(math.MaxUint64)
+		b.WriteFloat(math.Pi, 'f', 4)
+		b.WriteFloat(1e-300, 'g', -1)
+		b.WriteFixed(199, 2)
+		b.WriteBool(true)
+		b.WriteQuoted("x\ty")
+		b.WriteJSONString("café", 0)
+	})
+	if allocs != 0 {
+		t.Errorf("Buffer writes: %v allocations, want 0", allocs)
+	}
+
+	b = Buffer{}
+	grows := 0
+	for i := 0; i < 10000; i++ {
+		c := b.Cap()
+		b.WriteInt(int64(i))
+		if b.Cap() != c {
+			grows++
+		}
+	}
+	if grows > 16 {
+		t.Errorf("10000 WriteInt calls grew the buffer %d times", grows)
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Fastparse-bench compares fastparse with strconv and prints the comparison
+// tables in the README.
+//
+// It times each operation over a set of named input distributions, once
+// under each implementation the CPU supports (forced with
+// fastparse.SetImplementation). Samples of fastparse and strconv are taken
+// alternately, so that drift in the machine's speed affects both, and the
+// speedup is estimated from the paired samples. Every time and speedup is
+// reported with its 95% confidence interval.
+//
+// Usage:
+//
+//	fastparse-bench [flags]
+//
+// The flags are:
+//
+//	-op list
+//		Only run the comma-separated operations: ParseFloat, ParseInt,
+//		ParseUint, Atoi, AppendFloat, AppendInt, AppendIntHex (base 16),
+//		IsGraphic, AppendQuote, QuoteToASCII and Unquote.
+//	-dist substr
+//		Only run the distributions whose names contain substr.
+//	-impl list
+//		Only use the comma-separated implementations, e.g. Generic,AVX2.
+//	-file op=path
+//		Also run op over the inputs in path, one per line. Use this to
+//		measure real-world samples; the flag may be repeated.
+//	-count n
+//		Take n samples of each measurement (default 10). With n=1 there is
+//		no confidence interval, and speedups are not judged (n/a).
+//	-time d
+//		Run each sample for about d (default 100ms).
+//	-format f
+//		Print the results as markdown (the default), csv or json.
+//	-o file
+//		Write the results to file instead of standard output.
+//
+// Fastparse-bench exits with status 1 if an input file cannot be read or
+// an output written, and 2 on a usage error.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/mshafiee/fastparse"
+	"github.com/mshafiee/fastparse/internal/dispatch"
+)
+
+var (
+	opFlag    = flag.String("op", "", "comma-separated operations to run (default: all)")
+	distFlag  = flag.String("dist", "", "only run distributions whose names contain `substr`")
+	implFlag  = flag.String("impl", "", "comma-separated implementations to use (default: all supported)")
+	count     = flag.Int("count", 10, "samples per measurement")
+	sampleDur = flag.Duration("time", 100*time.Millisecond, "duration of each sample")
+	format    = flag.String("format", "markdown", "output format: markdown, csv or json")
+	output    = flag.String("o", "", "write results to `file`")
+	files     sampleFiles
+)
+
+var writers = map[string]func(io.Writer, environment, []result) error{
+	"markdown": writeMarkdown,
+	"csv":      writeCSV,
+	"json":     writeJSON,
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: fastparse-bench [flags]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	flag.Var(&files, "file", "also run `op=path` over the lines of path")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 0 || *count < 1 || *sampleDur <= 0 {
+		usage()
+	}
+	write := writers[*format]
+	if write == nil {
+		fmt.Fprintf(os.Stderr, "fastparse-bench: unknown format %q\n", *format)
+		os.Exit(2)
+	}
+	impls, err := dispatch.ParseLevels[fastparse.Implementation](*implFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fastparse-bench: %v\n", err)
+		os.Exit(2)
+	}
+	selected, err := selectOps(*opFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fastparse-bench: %v\n", err)
+		os.Exit(2)
+	}
+
+	var results []result
+	orig := fastparse.Features().Current
+	for _, o := range selected {
+		ds := o.dists()
+		for _, f := range files {
+			if f.op != o {
+				continue
+			}
+			d, err := f.read()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "fastparse-bench: %v\n", err)
+				os.Exit(1)
+			}
+			ds = append(ds, d)
+		}
+		for _, d := range ds {
+			if !strings.Contains(d.name, *distFlag) {
+				continue
+			}
+			rs, err := run(o, d, impls)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "fastparse-bench: %s, %s: %v\n", o.name, d.name, err)
+				os.Exit(1)
+			}
+			results = append(results, rs...)
+		}
+	}
+	fastparse.SetImplementation(orig)
+
+	w := os.Stdout
+	if *output != "" {
+		w, err = os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "fastparse-bench: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	env := environment{
+		GoVersion: runtime.Version(),
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+		CPUs:      runtime.NumCPU(),
+		Features:  cpuFeatures(),
+		Count:     *count,
+		Time:      sampleDur.String(),
+	}
+	if err := write(w, env, results); err == nil && w != os.Stdout {
+		err = w.Close()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fastparse-bench: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run measures op over d under each implementation. Each round takes a
+// strconv sample and then a fastparse sample per implementation, and the
+// speedup under an implementation is estimated from the ratios within
+// rounds.
+func run(o *op, d dist, impls []fastparse.Implementation) ([]result, error) {
+	fast, std, err := o.build(d.inputs)
+	if err != nil {
+		return nil, err
+	}
+	n := calibrate(std, *sampleDur)
+	fastN := make([]int, len(impls))
+	for i, impl := range impls {
+		fastparse.SetImplementation(impl)
+		fastN[i] = calibrate(fast, *sampleDur)
+	}
+
+	stdNs := make([]float64, *count)
+	fastNs := make([][]float64, len(impls))
+	ratios := make([][]float64, len(impls))
+	var stdAllocs float64
+	fastAllocs := make([]float64, len(impls))
+	for round := 0; round < *count; round++ {
+		var allocs float64
+		stdNs[round], allocs = measure(std, n)
+		stdAllocs += allocs / float64(*count)
+		for i, impl := range impls {
+			fastparse.SetImplementation(impl)
+			t, allocs := measure(fast, fastN[i])
+			fastNs[i] = append(fastNs[i], t)
+			ratios[i] = append(ratios[i], stdNs[round]/t)
+			fastAllocs[i] += allocs / float64(*count)
+		}
+	}
+
+	rs := make([]result, len(impls))
+	for i, impl := range impls {
+		rs[i] = result{
+			Op:             o.name,
+			Dist:           d.name,
+			Impl:           impl.String(),
+			Fastparse:      estimateOf(fastNs[i]),
+			Strconv:        estimateOf(stdNs),
+			Speedup:        estimateOf(ratios[i]),
+			FastparseAlloc: fastAllocs[i],
+			StrconvAlloc:   stdAllocs,
+		}
+	}
+	return rs, nil
+}
+
+func selectOps(list string) ([]*op, error) {
+	if list == "" {
+		return ops, nil
+	}
+	var selected []*op
+	for _, name := range strings.Split(list, ",") {
+		o := findOp(name)
+		if o == nil {
+			return nil, fmt.Errorf("unknown operation %q", name)
+		}
+		selected = append(selected, o)
+	}
+	return selected, nil
+}
+
+func cpuFeatures() []string {
+	f := fastparse.Features()
+	var names []string
+	for _, c := range []struct {
+		name string
+		has  bool
+	}{{"SSE2", f.SSE2}, {"AVX2", f.AVX2}, {"AVX512F", f.AVX512F}, {"AVX512BW", f.AVX512BW}, {"BMI2", f.BMI2}, {"FMA", f.FMA}, {"NEON", f.NEON}} {
+		if c.has {
+			names = append(names, c.name)
+		}
+	}
+	return names
+}
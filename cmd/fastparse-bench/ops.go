@@ -0,0 +1,415 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mshafiee/fastparse"
+)
+
+// sampleCount is the number of inputs in each built-in distribution.
+const sampleCount = 1024
+
+// A dist is a named set of inputs, cycled through by the benchmarks.
+type dist struct {
+	name   string
+	inputs []string
+}
+
+// An op is a function benchmarked against its strconv counterpart.
+// build prepares the inputs, for example parsing them for a format
+// function, and returns loops that call each function n times.
+type op struct {
+	name  string
+	dists func() []dist
+	build func(inputs []string) (fast, std func(n int), err error)
+}
+
+// sink keeps results alive so the calls are not optimized away.
+var sink struct {
+	f float64
+	i int64
+	u uint64
+	n int
+	b []byte
+	s string
+	t bool
+}
+
+var ops = []*op{
+	{"ParseFloat", floatDists, func(in []string) (func(int), func(int), error) {
+		return func(n int) {
+				for i := 0; i < n; i++ {
+					sink.f, _ = fastparse.ParseFloat(in[i%len(in)], 64)
+				}
+			}, func(n int) {
+				for i := 0; i < n; i++ {
+					sink.f, _ = strconv.ParseFloat(in[i%len(in)], 64)
+				}
+			}, nil
+	}},
+	{"ParseInt", intDists, func(in []string) (func(int), func(int), error) {
+		return func(n int) {
+				for i := 0; i < n; i++ {
+					sink.i, _ = fastparse.ParseInt(in[i%len(in)], 10, 64)
+				}
+			}, func(n int) {
+				for i := 0; i < n; i++ {
+					sink.i, _ = strconv.ParseInt(in[i%len(in)], 10, 64)
+				}
+			}, nil
+	}},
+	{"ParseUint", uintDists, func(in []string) (func(int), func(int), error) {
+		return func(n int) {
+				for i := 0; i < n; i++ {
+					sink.u, _ = fastparse.ParseUint(in[i%len(in)], 10, 64)
+				}
+			}, func(n int) {
+				for i := 0; i < n; i++ {
+					sink.u, _ = strconv.ParseUint(in[i%len(in)], 10, 64)
+				}
+			}, nil
+	}},
+	{"Atoi", intDists, func(in []string) (func(int), func(int), error) {
+		return func(n int) {
+				for i := 0; i < n; i++ {
+					sink.n, _ = fastparse.Atoi(in[i%len(in)])
+				}
+			}, func(n int) {
+				for i := 0; i < n; i++ {
+					sink.n, _ = strconv.Atoi(in[i%len(in)])
+				}
+			}, nil
+	}},
+	{"AppendFloat", floatDists, func(in []string) (func(int), func(int), error) {
+		vs := make([]float64, len(in))
+		for i, s := range in {
+			v, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, nil, err
+			}
+			vs[i] = v
+		}
+		buf := make([]byte, 0, 64)
+		return func(n int) {
+				for i := 0; i < n; i++ {
+					sink.b = fastparse.AppendFloat(buf, vs[i%len(vs)], 'g', -1, 64)
+				}
+			}, func(n int) {
+				for i := 0; i < n; i++ {
+					sink.b = strconv.AppendFloat(buf, vs[i%len(vs)], 'g', -1, 64)
+				}
+			}, nil
+	}},
+	{"AppendInt", intDists, func(in []string) (func(int), func(int), error) {
+		vs := make([]int64, len(in))
+		for i, s := range in {
+			v, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, nil, err
+			}
+			vs[i] = v
+		}
+		buf := make([]byte, 0, 24)
+		return func(n int) {
+				for i := 0; i < n; i++ {
+					sink.b = fastparse.AppendInt(buf, vs[i%len(vs)], 10)
+				}
+			}, func(n int) {
+				for i := 0; i < n; i++ {
+					sink.b = strconv.AppendInt(buf, vs[i%len(vs)], 10)
+				}
+			}, nil
+	}},
+	{"AppendIntHex", intDists, func(in []string) (func(int), func(int), error) {
+		vs := make([]int64, len(in))
+		for i, s := range in {
+			v, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, nil, err
+			}
+			vs[i] = v
+		}
+		buf := make([]byte, 0, 24)
+		return func(n int) {
+				for i := 0; i < n; i++ {
+					sink.b = fastparse.AppendInt(buf, vs[i%len(vs)], 16)
+				}
+			}, func(n int) {
+				for i := 0; i < n; i++ {
+					sink.b = strconv.AppendInt(buf, vs[i%len(vs)], 16)
+				}
+			}, nil
+	}},
+	{"IsGraphic", runeDists, func(in []string) (func(int), func(int), error) {
+		rs := make([]rune, len(in))
+		for i, s := range in {
+			r, size := utf8.DecodeRuneInString(s)
+			if size != len(s) {
+				return nil, nil, fmt.Errorf("%q is not one rune", s)
+			}
+			rs[i] = r
+		}
+		return func(n int) {
+				for i := 0; i < n; i++ {
+					sink.t = fastparse.IsGraphic(rs[i%len(rs)])
+				}
+			}, func(n int) {
+				for i := 0; i < n; i++ {
+					sink.t = strconv.IsGraphic(rs[i%len(rs)])
+				}
+			}, nil
+	}},
+	{"AppendQuote", textDists, func(in []string) (func(int), func(int), error) {
+		buf := make([]byte, 0, 2*maxLen(in)+2)
+		return func(n int) {
+				for i := 0; i < n; i++ {
+					sink.b = fastparse.AppendQuote(buf, in[i%len(in)])
+				}
+			}, func(n int) {
+				for i := 0; i < n; i++ {
+					sink.b = strconv.AppendQuote(buf, in[i%len(in)])
+				}
+			}, nil
+	}},
+	{"QuoteToASCII", textDists, func(in []string) (func(int), func(int), error) {
+		return func(n int) {
+				for i := 0; i < n; i++ {
+					sink.s = fastparse.QuoteToASCII(in[i%len(in)])
+				}
+			}, func(n int) {
+				for i := 0; i < n; i++ {
+					sink.s = strconv.QuoteToASCII(in[i%len(in)])
+				}
+			}, nil
+	}},
+	{"Unquote", quotedDists, func(in []string) (func(int), func(int), error) {
+		for _, s := range in {
+			if _, err := strconv.Unquote(s); err != nil {
+				return nil, nil, fmt.Errorf("%q: %v", s, err)
+			}
+		}
+		return func(n int) {
+				for i := 0; i < n; i++ {
+					sink.s, _ = fastparse.Unquote(in[i%len(in)])
+				}
+			}, func(n int) {
+				for i := 0; i < n; i++ {
+					sink.s, _ = strconv.Unquote(in[i%len(in)])
+				}
+			}, nil
+	}},
+}
+
+func findOp(name string) *op {
+	for _, o := range ops {
+		if strings.EqualFold(o.name, name) {
+			return o
+		}
+	}
+	return nil
+}
+
+func maxLen(in []string) int {
+	n := 0
+	for _, s := range in {
+		n = max(n, len(s))
+	}
+	return n
+}
+
+// generate returns a distribution of sampleCount inputs made by gen from
+// a fixed seed, so that every run measures the same inputs.
+func generate(name string, gen func(r *rand.Rand) string) dist {
+	r := rand.New(rand.NewSource(1))
+	in := make([]string, sampleCount)
+	for i := range in {
+		in[i] = gen(r)
+	}
+	return dist{name, in}
+}
+
+func fixed(name, s string) dist {
+	return dist{name, []string{s}}
+}
+
+// digits returns n random decimal digits, the first nonzero.
+func digits(r *rand.Rand, n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte('0' + r.Intn(10))
+	}
+	b[0] = byte('1' + r.Intn(9))
+	return string(b)
+}
+
+// withPoint places a decimal point among n random digits.
+func withPoint(r *rand.Rand, n int) string {
+	d := digits(r, n)
+	i := 1 + r.Intn(n-1)
+	return d[:i] + "." + d[i:]
+}
+
+func floatDists() []dist {
+	return []dist{
+		generate("short (4 digits)", func(r *rand.Rand) string { return withPoint(r, 4) }),
+		generate("medium (8 digits)", func(r *rand.Rand) string { return withPoint(r, 8) }),
+		generate("long (16 digits)", func(r *rand.Rand) string { return withPoint(r, 16) }),
+		generate("scientific", func(r *rand.Rand) string {
+			return withPoint(r, 4) + "e" + strconv.Itoa(r.Intn(61)-30)
+		}),
+		generate("scientific (long)", func(r *rand.Rand) string {
+			return withPoint(r, 17) + "e" + strconv.Itoa(r.Intn(601)-300)
+		}),
+		generate("random bits", func(r *rand.Rand) string {
+			for {
+				f := math.Float64frombits(r.Uint64())
+				if !math.IsNaN(f) && !math.IsInf(f, 0) {
+					return strconv.FormatFloat(f, 'g', -1, 64)
+				}
+			}
+		}),
+	}
+}
+
+func signed(r *rand.Rand, s string) string {
+	if r.Intn(2) == 0 {
+		return "-" + s
+	}
+	return s
+}
+
+func intDists() []dist {
+	return []dist{
+		generate("short (4 digits)", func(r *rand.Rand) string { return signed(r, digits(r, 4)) }),
+		generate("medium (8 digits)", func(r *rand.Rand) string { return signed(r, digits(r, 8)) }),
+		generate("long (16 digits)", func(r *rand.Rand) string { return signed(r, digits(r, 16)) }),
+		fixed("max positive (19 digits)", strconv.FormatInt(math.MaxInt64, 10)),
+		fixed("max negative (19 digits)", strconv.FormatInt(math.MinInt64, 10)),
+		generate("random", func(r *rand.Rand) string { return strconv.FormatInt(int64(r.Uint64())>>r.Intn(63), 10) }),
+	}
+}
+
+func uintDists() []dist {
+	return []dist{
+		generate("short (4 digits)", func(r *rand.Rand) string { return digits(r, 4) }),
+		generate("medium (8 digits)", func(r *rand.Rand) string { return digits(r, 8) }),
+		generate("long (16 digits)", func(r *rand.Rand) string { return digits(r, 16) }),
+		fixed("MaxUint64 (20 digits)", strconv.FormatUint(math.MaxUint64, 10)),
+	}
+}
+
+// runeDists returns characters from Latin, Cyrillic, CJK and emoji, some
+// of them not graphic.
+func runeDists() []dist {
+	return []dist{
+		generate("ASCII", func(r *rand.Rand) string { return string(rune(r.Intn(128))) }),
+		generate("BMP", func(r *rand.Rand) string {
+			for {
+				if c := rune(r.Intn(0x10000)); utf8.ValidRune(c) {
+					return string(c)
+				}
+			}
+		}),
+		generate("mixed scripts", func(r *rand.Rand) string {
+			const chars = "aZ9 \té\u00adЖж中文😀\u200b\u2028"
+			rs := []rune(chars)
+			return string(rs[r.Intn(len(rs))])
+		}),
+	}
+}
+
+// prose returns n bytes of ASCII text with a newline every 45 bytes.
+func prose(n int) string {
+	const text = "The quick brown fox jumps over the lazy dog. "
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = text[i%len(text)]
+		if i%45 == 44 {
+			b[i] = '\n'
+		}
+	}
+	return string(b)
+}
+
+var textSizes = []struct {
+	name string
+	n    int
+}{{"8 bytes", 8}, {"16 bytes", 16}, {"32 bytes", 32}, {"64 bytes", 64}, {"256 bytes", 256}, {"1 KB", 1 << 10}, {"4 KB", 4 << 10}}
+
+func textDists() []dist {
+	var ds []dist
+	for _, sz := range textSizes {
+		ds = append(ds, fixed(sz.name, prose(sz.n)))
+	}
+	ds = append(ds, fixed("unicode (256 bytes)", strings.Repeat("héllo wörld, 世界 ", 256/20)))
+	return ds
+}
+
+func quotedDists() []dist {
+	var ds []dist
+	for _, d := range textDists() {
+		ds = append(ds, fixed(d.name, strconv.Quote(d.inputs[0])))
+	}
+	return ds
+}
+
+// A sampleFile is an op and a file of its inputs, one per line, given on
+// the command line as op=path.
+type sampleFile struct {
+	op   *op
+	path string
+}
+
+type sampleFiles []sampleFile
+
+func (f *sampleFiles) String() string { return "" }
+
+func (f *sampleFiles) Set(v string) error {
+	name, path, ok := strings.Cut(v, "=")
+	if !ok {
+		return fmt.Errorf("want op=path")
+	}
+	o := findOp(name)
+	if o == nil {
+		return fmt.Errorf("unknown op %q", name)
+	}
+	*f = append(*f, sampleFile{o, path})
+	return nil
+}
+
+// read returns the non-empty lines of the file as a distribution named
+// after it.
+func (f sampleFile) read() (dist, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return dist{}, err
+	}
+	defer file.Close()
+	var in []string
+	s := bufio.NewScanner(file)
+	s.Buffer(nil, 1<<20)
+	for s.Scan() {
+		if line := s.Text(); line != "" {
+			in = append(in, line)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return dist{}, err
+	}
+	if len(in) == 0 {
+		return dist{}, fmt.Errorf("%s: no samples", f.path)
+	}
+	return dist{"file " + filepath.Base(f.path), in}, nil
+}
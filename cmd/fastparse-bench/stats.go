@@ -0,0 +1,187 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// measure calls f n times and returns the time and the number of heap
+// allocations per call.
+func measure(f func(n int), n int) (nsPerOp, allocsPerOp float64) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	f(n)
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+	return float64(elapsed.Nanoseconds()) / float64(n), float64(after.Mallocs-before.Mallocs) / float64(n)
+}
+
+// calibrate returns the number of calls of f that take about d.
+func calibrate(f func(n int), d time.Duration) int {
+	n := 1
+	for {
+		start := time.Now()
+		f(n)
+		elapsed := time.Since(start)
+		if elapsed >= d/10 || n >= 1<<30 {
+			if elapsed <= 0 {
+				return n
+			}
+			return max(1, int(float64(n)*float64(d)/float64(elapsed)))
+		}
+		n *= 10
+	}
+}
+
+// An estimate is a mean with the half-width of its 95% confidence
+// interval.
+type estimate struct {
+	Mean float64 `json:"mean"`
+	CI   float64 `json:"ci95"`
+}
+
+// MarshalJSON writes a missing interval, from a single sample, as null:
+// NaN is not valid JSON, and 0 would claim zero variance.
+func (e estimate) MarshalJSON() ([]byte, error) {
+	var ci *float64
+	if !math.IsNaN(e.CI) {
+		ci = &e.CI
+	}
+	return json.Marshal(struct {
+		Mean float64  `json:"mean"`
+		CI   *float64 `json:"ci95"`
+	}{e.Mean, ci})
+}
+
+func estimateOf(xs []float64) estimate {
+	n := float64(len(xs))
+	var sum float64
+	for _, x := range xs {
+		sum += x
+	}
+	mean := sum / n
+	if len(xs) < 2 {
+		return estimate{mean, math.NaN()}
+	}
+	var ss float64
+	for _, x := range xs {
+		ss += (x - mean) * (x - mean)
+	}
+	sd := math.Sqrt(ss / (n - 1))
+	return estimate{mean, tQuantile(len(xs)-1) * sd / math.Sqrt(n)}
+}
+
+// tQuantile returns the 97.5th percentile of Student's t distribution
+// with df degrees of freedom.
+func tQuantile(df int) float64 {
+	table := [...]float64{
+		12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+		2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+		2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+	}
+	if df >= 1 && df <= len(table) {
+		return table[df-1]
+	}
+	return 1.96
+}
+
+// A result compares one op on one distribution under one implementation.
+type result struct {
+	Op             string   `json:"op"`
+	Dist           string   `json:"dist"`
+	Impl           string   `json:"impl"`
+	Fastparse      estimate `json:"fastparse_ns_per_op"`
+	Strconv        estimate `json:"strconv_ns_per_op"`
+	Speedup        estimate `json:"speedup"`
+	FastparseAlloc float64  `json:"fastparse_allocs_per_op"`
+	StrconvAlloc   float64  `json:"strconv_allocs_per_op"`
+}
+
+// environment describes the machine the results were taken on.
+type environment struct {
+	GoVersion string   `json:"go_version"`
+	GOOS      string   `json:"goos"`
+	GOARCH    string   `json:"goarch"`
+	CPUs      int      `json:"cpus"`
+	Features  []string `json:"cpu_features"`
+	Count     int      `json:"count"`
+	Time      string   `json:"time_per_sample"`
+}
+
+func ns(e estimate) string {
+	return fmt.Sprintf("%s ± %s ns/op", num(e.Mean), num(e.CI))
+}
+
+func num(x float64) string {
+	switch {
+	case math.IsNaN(x):
+		return "?"
+	case x >= 100:
+		return strconv.FormatFloat(x, 'f', 0, 64)
+	case x >= 10:
+		return strconv.FormatFloat(x, 'f', 1, 64)
+	}
+	return strconv.FormatFloat(x, 'f', 2, 64)
+}
+
+func speedup(e estimate) string {
+	s := fmt.Sprintf("%.2fx", e.Mean)
+	if math.IsNaN(e.CI) {
+		// One sample gives no interval to compare with 1.
+		return s + " n/a"
+	}
+	s += fmt.Sprintf(" (%.2f–%.2f)", e.Mean-e.CI, e.Mean+e.CI)
+	switch {
+	case e.Mean-e.CI > 1:
+		return "**" + s + " faster**"
+	case e.Mean+e.CI < 1:
+		return s + " slower"
+	}
+	return s + " same"
+}
+
+func writeMarkdown(w io.Writer, env environment, results []result) error {
+	fmt.Fprintf(w, "%s %s/%s, %d CPUs, features %s; %d samples of %s each, 95%% confidence intervals.\n\n",
+		env.GoVersion, env.GOOS, env.GOARCH, env.CPUs, strings.Join(env.Features, " "), env.Count, env.Time)
+	fmt.Fprintln(w, "| Operation | Input Type | Implementation | FastParse | Strconv | Speedup | Allocs (FastParse/Strconv) |")
+	fmt.Fprintln(w, "|-----------|-----------|----------------|-----------|---------|---------|-----------------------------|")
+	for _, r := range results {
+		fmt.Fprintf(w, "| `%s` | %s | %s | %s | %s | %s | %s / %s |\n",
+			r.Op, r.Dist, r.Impl, ns(r.Fastparse), ns(r.Strconv), speedup(r.Speedup), num(r.FastparseAlloc), num(r.StrconvAlloc))
+	}
+	return nil
+}
+
+func writeCSV(w io.Writer, env environment, results []result) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"op", "dist", "impl", "fastparse_ns", "fastparse_ci95", "strconv_ns", "strconv_ci95",
+		"speedup", "speedup_ci95", "fastparse_allocs", "strconv_allocs"})
+	f := func(x float64) string { return strconv.FormatFloat(x, 'g', 6, 64) }
+	for _, r := range results {
+		cw.Write([]string{r.Op, r.Dist, r.Impl, f(r.Fastparse.Mean), f(r.Fastparse.CI), f(r.Strconv.Mean), f(r.Strconv.CI),
+			f(r.Speedup.Mean), f(r.Speedup.CI), f(r.FastparseAlloc), f(r.StrconvAlloc)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeJSON(w io.Writer, env environment, results []result) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Environment environment `json:"environment"`
+		Results     []result    `json:"results"`
+	}{env, results})
+}
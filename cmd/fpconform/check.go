@@ -0,0 +1,270 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mshafiee/fastparse"
+)
+
+// An input is a string to test, with the base for integer inputs.
+type input struct {
+	s    string
+	base int
+}
+
+// A check compares one fastparse function with its strconv counterpart.
+// run returns the two results rendered as strings, which are equal when
+// the functions agree.
+type check struct {
+	name string
+	run  func(in input) (got, want string)
+}
+
+// result renders a value and error. Errors are compared by the error at
+// the bottom of the chain and, for a NumError, its Func and Num, not by
+// their text: fastparse's NumError quotes Num with QuoteToASCII.
+func result(v any, err error) string {
+	var fe *fastparse.NumError
+	var se *strconv.NumError
+	switch {
+	case errors.As(err, &fe):
+		return fmt.Sprintf("%v, %s(%q): %s", v, fe.Func, fe.Num, rootError(err))
+	case errors.As(err, &se):
+		return fmt.Sprintf("%v, %s(%q): %s", v, se.Func, se.Num, rootError(err))
+	}
+	return fmt.Sprintf("%v, %s", v, rootError(err))
+}
+
+// unquoteResult is like result but ignores the NumError, which fastparse
+// wraps around the errors of Unquote and QuotedPrefix and strconv does not.
+func unquoteResult(v string, err error) string {
+	return fmt.Sprintf("%q, %s", v, rootError(err))
+}
+
+func rootError(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+	for errors.Unwrap(err) != nil {
+		err = errors.Unwrap(err)
+	}
+	return err.Error()
+}
+
+// floatResult renders f by its bits, so that the sign of zero and NaN
+// payloads count.
+func floatResult(f float64, err error) string {
+	return result(fmt.Sprintf("%v (%#016x)", f, math.Float64bits(f)), err)
+}
+
+// floatChecks holds the checks run on decimal and hexadecimal float
+// inputs: parsing at both sizes, and formatting the parsed value in every
+// format at a spread of precisions.
+var floatChecks = func() []*check {
+	cs := []*check{
+		{"ParseFloat(s, 64)", func(in input) (string, string) {
+			return floatResult(fastparse.ParseFloat(in.s, 64)), floatResult(strconv.ParseFloat(in.s, 64))
+		}},
+		{"ParseFloat(s, 32)", func(in input) (string, string) {
+			return floatResult(fastparse.ParseFloat(in.s, 32)), floatResult(strconv.ParseFloat(in.s, 32))
+		}},
+		{"ParseComplex(s, 128)", func(in input) (string, string) {
+			return result(fastparse.ParseComplex(in.s, 128)), result(strconv.ParseComplex(in.s, 128))
+		}},
+		{"FormatComplex(complex(f, -f), 'g', -1, 128)", func(in input) (string, string) {
+			f, err := strconv.ParseFloat(in.s, 64)
+			if err != nil {
+				return "", ""
+			}
+			c := complex(f, -f)
+			return fastparse.FormatComplex(c, 'g', -1, 128), strconv.FormatComplex(c, 'g', -1, 128)
+		}},
+	}
+	for _, bitSize := range []int{64, 32} {
+		for _, fmtc := range []byte{'b', 'e', 'E', 'f', 'g', 'G', 'x', 'X'} {
+			for _, prec := range []int{-1, 0, 1, 6, 16, 17, 25} {
+				if fmtc == 'b' && prec != -1 {
+					continue
+				}
+				cs = append(cs, formatFloatCheck(fmtc, prec, bitSize))
+			}
+		}
+	}
+	cs = append(cs, &check{"AppendFloat(dst, f, 'g', -1, 64)", func(in input) (string, string) {
+		f, err := strconv.ParseFloat(in.s, 64)
+		if err != nil {
+			return "", ""
+		}
+		return string(fastparse.AppendFloat([]byte("x="), f, 'g', -1, 64)), string(strconv.AppendFloat([]byte("x="), f, 'g', -1, 64))
+	}})
+	return cs
+}()
+
+// formatFloatCheck returns a check of FormatFloat on the value strconv
+// parses from the input, skipping inputs strconv rejects.
+func formatFloatCheck(fmtc byte, prec, bitSize int) *check {
+	return &check{
+		name: fmt.Sprintf("FormatFloat(f, '%c', %d, %d)", fmtc, prec, bitSize),
+		run: func(in input) (string, string) {
+			f, err := strconv.ParseFloat(in.s, bitSize)
+			if err != nil {
+				return "", ""
+			}
+			return fastparse.FormatFloat(f, fmtc, prec, bitSize), strconv.FormatFloat(f, fmtc, prec, bitSize)
+		},
+	}
+}
+
+// intChecks holds the checks run on integer inputs: parsing in the input's
+// base and with base 0 at every bit size, and formatting the parsed value.
+var intChecks = func() []*check {
+	var cs []*check
+	for _, bitSize := range []int{0, 8, 16, 32, 64} {
+		cs = append(cs,
+			&check{fmt.Sprintf("ParseInt(s, base, %d)", bitSize), func(in input) (string, string) {
+				return result(fastparse.ParseInt(in.s, in.base, bitSize)), result(strconv.ParseInt(in.s, in.base, bitSize))
+			}},
+			&check{fmt.Sprintf("ParseUint(s, base, %d)", bitSize), func(in input) (string, string) {
+				return result(fastparse.ParseUint(in.s, in.base, bitSize)), result(strconv.ParseUint(in.s, in.base, bitSize))
+			}},
+		)
+	}
+	cs = append(cs,
+		&check{"ParseInt(s, 0, 64)", func(in input) (string, string) {
+			return result(fastparse.ParseInt(in.s, 0, 64)), result(strconv.ParseInt(in.s, 0, 64))
+		}},
+		&check{"ParseUint(s, 0, 64)", func(in input) (string, string) {
+			return result(fastparse.ParseUint(in.s, 0, 64)), result(strconv.ParseUint(in.s, 0, 64))
+		}},
+		&check{"Atoi(s)", func(in input) (string, string) {
+			return result(fastparse.Atoi(in.s)), result(strconv.Atoi(in.s))
+		}},
+		&check{"FormatInt(i, base)", func(in input) (string, string) {
+			i, err := strconv.ParseInt(in.s, in.base, 64)
+			if err != nil || in.base < 2 {
+				return "", ""
+			}
+			return fastparse.FormatInt(i, in.base), strconv.FormatInt(i, in.base)
+		}},
+		&check{"FormatUint(u, base)", func(in input) (string, string) {
+			u, err := strconv.ParseUint(in.s, in.base, 64)
+			if err != nil || in.base < 2 {
+				return "", ""
+			}
+			return fastparse.FormatUint(u, in.base), strconv.FormatUint(u, in.base)
+		}},
+		&check{"Itoa(i)", func(in input) (string, string) {
+			i, err := strconv.ParseInt(in.s, in.base, 0)
+			if err != nil {
+				return "", ""
+			}
+			return fastparse.Itoa(int(i)), strconv.Itoa(int(i))
+		}},
+		&check{"AppendInt(dst, i, 10)", func(in input) (string, string) {
+			i, err := strconv.ParseInt(in.s, in.base, 64)
+			if err != nil {
+				return "", ""
+			}
+			return string(fastparse.AppendInt([]byte("n="), i, 10)), string(strconv.AppendInt([]byte("n="), i, 10))
+		}},
+		&check{"AppendUint(dst, u, 10)", func(in input) (string, string) {
+			u, err := strconv.ParseUint(in.s, in.base, 64)
+			if err != nil {
+				return "", ""
+			}
+			return string(fastparse.AppendUint([]byte("n="), u, 10)), string(strconv.AppendUint([]byte("n="), u, 10))
+		}},
+	)
+	return cs
+}()
+
+// quoteChecks holds the checks run on arbitrary strings: quoting,
+// unquoting the string in each kind of quotes and as strconv quotes it,
+// the per-rune functions, and ParseBool.
+var quoteChecks = []*check{
+	{"Quote(s)", func(in input) (string, string) {
+		return fastparse.Quote(in.s), strconv.Quote(in.s)
+	}},
+	{"QuoteToASCII(s)", func(in input) (string, string) {
+		return fastparse.QuoteToASCII(in.s), strconv.QuoteToASCII(in.s)
+	}},
+	{"QuoteToGraphic(s)", func(in input) (string, string) {
+		return fastparse.QuoteToGraphic(in.s), strconv.QuoteToGraphic(in.s)
+	}},
+	{"AppendQuote(dst, s)", func(in input) (string, string) {
+		return string(fastparse.AppendQuote([]byte("s="), in.s)), string(strconv.AppendQuote([]byte("s="), in.s))
+	}},
+	{"AppendQuoteToASCII(dst, s)", func(in input) (string, string) {
+		return string(fastparse.AppendQuoteToASCII([]byte("s="), in.s)), string(strconv.AppendQuoteToASCII([]byte("s="), in.s))
+	}},
+	{"AppendQuoteToGraphic(dst, s)", func(in input) (string, string) {
+		return string(fastparse.AppendQuoteToGraphic([]byte("s="), in.s)), string(strconv.AppendQuoteToGraphic([]byte("s="), in.s))
+	}},
+	{"CanBackquote(s)", func(in input) (string, string) {
+		return fmt.Sprint(fastparse.CanBackquote(in.s)), fmt.Sprint(strconv.CanBackquote(in.s))
+	}},
+	{`Unquote("\"" + s + "\"")`, unquoteCheck(`"`)},
+	{`Unquote("'" + s + "'")`, unquoteCheck(`'`)},
+	{"Unquote(\"`\" + s + \"`\")", unquoteCheck("`")},
+	{"Unquote(strconv.Quote(s))", func(in input) (string, string) {
+		q := strconv.Quote(in.s)
+		return unquoteResult(fastparse.Unquote(q)), unquoteResult(strconv.Unquote(q))
+	}},
+	{"QuotedPrefix(s)", func(in input) (string, string) {
+		return unquoteResult(fastparse.QuotedPrefix(in.s)), unquoteResult(strconv.QuotedPrefix(in.s))
+	}},
+	{"UnquoteChar(s, '\"')", func(in input) (string, string) {
+		return unquoteChar(fastparse.UnquoteChar(in.s, '"')), unquoteChar(strconv.UnquoteChar(in.s, '"'))
+	}},
+	{"IsPrint(r)", runeCheck(func(r rune) string { return fmt.Sprint(fastparse.IsPrint(r)) }, func(r rune) string { return fmt.Sprint(strconv.IsPrint(r)) })},
+	{"IsGraphic(r)", runeCheck(func(r rune) string { return fmt.Sprint(fastparse.IsGraphic(r)) }, func(r rune) string { return fmt.Sprint(strconv.IsGraphic(r)) })},
+	{"QuoteRune(r)", runeCheck(fastparse.QuoteRune, strconv.QuoteRune)},
+	{"QuoteRuneToASCII(r)", runeCheck(fastparse.QuoteRuneToASCII, strconv.QuoteRuneToASCII)},
+	{"QuoteRuneToGraphic(r)", runeCheck(fastparse.QuoteRuneToGraphic, strconv.QuoteRuneToGraphic)},
+	{"AppendQuoteRune(dst, r)", runeCheck(
+		func(r rune) string { return string(fastparse.AppendQuoteRune([]byte("r="), r)) },
+		func(r rune) string { return string(strconv.AppendQuoteRune([]byte("r="), r)) })},
+	{"ParseBool(s)", func(in input) (string, string) {
+		return result(fastparse.ParseBool(in.s)), result(strconv.ParseBool(in.s))
+	}},
+}
+
+func unquoteCheck(q string) func(in input) (string, string) {
+	return func(in input) (string, string) {
+		s := q + in.s + q
+		return unquoteResult(fastparse.Unquote(s)), unquoteResult(strconv.Unquote(s))
+	}
+}
+
+func unquoteChar(value rune, multibyte bool, tail string, err error) string {
+	return result(fmt.Sprintf("%U, %v, %q", value, multibyte, tail), err)
+}
+
+// runeCheck returns a check applying f and g to every rune of the input,
+// skipping bytes that are not valid UTF-8.
+func runeCheck(f, g func(rune) string) func(in input) (string, string) {
+	return func(in input) (string, string) {
+		var got, want strings.Builder
+		for i := 0; i < len(in.s); {
+			r, width := utf8.DecodeRuneInString(in.s[i:])
+			i += width
+			if r == utf8.RuneError && width == 1 {
+				continue
+			}
+			got.WriteString(f(r))
+			got.WriteByte(' ')
+			want.WriteString(g(r))
+			want.WriteByte(' ')
+		}
+		return got.String(), want.String()
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// A generator produces inputs and names the checks to run on them.
+// Finite generators are drained even after the time budget runs out.
+type generator struct {
+	name   string
+	checks []*check
+	finite bool
+	done   bool
+	next   func() (input, bool)
+}
+
+// generators returns the generators named in list, or all of them if list
+// is empty, seeded from seed.
+func generators(list string, seed int64) ([]*generator, error) {
+	rng := rand.New(rand.NewSource(seed))
+	all := []*generator{
+		{name: "bits", checks: floatChecks, next: infinite(rng, genBits)},
+		{name: "halfway", checks: floatChecks, next: infinite(rng, genHalfway)},
+		{name: "long", checks: floatChecks, next: infinite(rng, genLong)},
+		{name: "testfp", checks: floatChecks, finite: true},
+		{name: "hex", checks: floatChecks, next: infinite(rng, genHex)},
+		{name: "int", checks: intChecks, next: infinite(rng, genInt)},
+		{name: "quote", checks: quoteChecks, next: infinite(rng, genQuote)},
+	}
+	var gens []*generator
+	for _, g := range all {
+		if list != "" && !inList(list, g.name) {
+			continue
+		}
+		if g.name == "testfp" {
+			cases, err := readTestfp(*testfpFile)
+			if err != nil {
+				if list == "" && !flagSet("testfp") {
+					fmt.Fprintf(os.Stderr, "fpconform: skipping testfp: %v\n", err)
+					continue
+				}
+				return nil, err
+			}
+			g.next = func() (input, bool) {
+				if len(cases) == 0 {
+					return input{}, false
+				}
+				in := input{s: cases[0]}
+				cases = cases[1:]
+				return in, true
+			}
+		}
+		gens = append(gens, g)
+	}
+	if list != "" {
+		for _, name := range strings.Split(list, ",") {
+			found := false
+			for _, g := range all {
+				found = found || g.name == name
+			}
+			if !found {
+				return nil, fmt.Errorf("unknown generator %q", name)
+			}
+		}
+	}
+	return gens, nil
+}
+
+func inList(list, name string) bool {
+	for _, s := range strings.Split(list, ",") {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+func infinite(rng *rand.Rand, gen func(*rand.Rand) input) func() (input, bool) {
+	return func() (input, bool) { return gen(rng), true }
+}
+
+// randFloat returns a float64 with random bits, biased towards the
+// subnormal and extreme exponents and, one time in four, exactly
+// representable as a float32.
+func randFloat(rng *rand.Rand) float64 {
+	bits := rng.Uint64()
+	switch rng.Intn(8) {
+	case 0:
+		bits &^= 0x7FF << 52 // subnormal or zero
+	case 1:
+		bits |= 0x7FE << 52 // near the top of the range
+	}
+	f := math.Float64frombits(bits)
+	if rng.Intn(4) == 0 {
+		f = float64(math.Float32frombits(uint32(bits)))
+	}
+	return f
+}
+
+// genBits formats a random float64 in one of the ways strconv prints
+// floats.
+func genBits(rng *rand.Rand) input {
+	f := randFloat(rng)
+	bitSize := 64
+	if float64(float32(f)) == f && rng.Intn(2) == 0 {
+		bitSize = 32
+	}
+	var s string
+	switch rng.Intn(5) {
+	case 0:
+		s = strconv.FormatFloat(f, 'g', -1, bitSize)
+	case 1:
+		s = strconv.FormatFloat(f, 'e', rng.Intn(25), bitSize)
+	case 2:
+		s = strconv.FormatFloat(f, 'f', -1, bitSize)
+	case 3:
+		s = strconv.FormatFloat(f, 'E', -1, bitSize)
+	default:
+		s = strconv.FormatFloat(f, 'g', rng.Intn(20)+1, bitSize)
+	}
+	if rng.Intn(8) == 0 && s[0] != '-' {
+		s = "+" + s
+	}
+	return input{s: s}
+}
+
+// genHalfway returns a decimal at, just above or just below the exact
+// midpoint between two adjacent floats, where rounding is hardest.
+func genHalfway(rng *rand.Rand) input {
+	f := math.Abs(randFloat(rng))
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		f = math.MaxFloat64
+	}
+	var next float64
+	if rng.Intn(3) == 0 {
+		f32 := float32(f)
+		f, next = float64(f32), float64(math.Nextafter32(f32, float32(math.Inf(1))))
+	} else {
+		next = math.Nextafter(f, math.Inf(1))
+	}
+	mid := new(big.Float).SetPrec(128).SetFloat64(f)
+	if math.IsInf(next, 0) {
+		// Halfway between MaxFloat64 and the next power of two.
+		ulp := new(big.Float).SetMantExp(big.NewFloat(1), 1023-52)
+		mid.Add(mid, ulp.Quo(ulp, big.NewFloat(2)))
+	} else {
+		mid.Add(mid, new(big.Float).SetPrec(128).SetFloat64(next))
+		mid.Quo(mid, big.NewFloat(2))
+	}
+	digits, exp := exactDecimal(mid)
+	switch rng.Intn(4) {
+	case 1: // just above
+		digits += strings.Repeat("0", rng.Intn(40)) + "1"
+	case 2: // just below, by truncating
+		if len(digits) > 2 {
+			digits = digits[:1+rng.Intn(len(digits)-1)]
+		}
+	case 3: // just below, by subtracting one far out
+		digits = decrement(digits + strings.Repeat("0", rng.Intn(40)+1))
+	}
+	return input{s: digits[:1] + "." + digits[1:] + "e" + strconv.Itoa(exp)}
+}
+
+// exactDecimal returns the exact decimal digits of x > 0 and the exponent
+// of the first digit.
+func exactDecimal(x *big.Float) (digits string, exp int) {
+	// A float's exact decimal expansion is at most 767 significant digits.
+	s := x.Text('e', 800)
+	mant, e, _ := strings.Cut(s, "e")
+	exp, _ = strconv.Atoi(e)
+	digits = strings.TrimRight(strings.Replace(mant, ".", "", 1), "0")
+	if digits == "" {
+		digits = "0"
+	}
+	return digits, exp
+}
+
+// decrement subtracts one from the last digit of digits, which must not be
+// all zeros.
+func decrement(digits string) string {
+	b := []byte(digits)
+	i := len(b) - 1
+	for ; b[i] == '0'; i-- {
+		b[i] = '9'
+	}
+	b[i]--
+	return string(b)
+}
+
+// genLong returns a long string of digits, often mostly nines or zeros,
+// with an optional point and exponent.
+func genLong(rng *rand.Rand) input {
+	n := 19 + rng.Intn(800)
+	if rng.Intn(2) == 0 {
+		n = 19 + rng.Intn(40)
+	}
+	b := make([]byte, 0, n+16)
+	fill := byte(0)
+	switch rng.Intn(4) {
+	case 0:
+		fill = '9'
+	case 1:
+		fill = '0'
+	}
+	for i := 0; i < n; i++ {
+		if fill != 0 && rng.Intn(50) != 0 {
+			b = append(b, fill)
+		} else {
+			b = append(b, byte('0'+rng.Intn(10)))
+		}
+	}
+	if b[0] == '0' && rng.Intn(2) == 0 {
+		b[0] = '1'
+	}
+	if rng.Intn(3) != 0 {
+		i := rng.Intn(len(b) + 1)
+		b = append(b[:i], append([]byte{'.'}, b[i:]...)...)
+	}
+	if rng.Intn(2) == 0 {
+		b = append(b, 'e')
+		b = strconv.AppendInt(b, int64(rng.Intn(800)-400-n/2), 10)
+	}
+	if rng.Intn(4) == 0 {
+		b = append([]byte{'-'}, b...)
+	}
+	return input{s: string(b)}
+}
+
+// genHex returns a hexadecimal float, sometimes with more digits than fit,
+// underscores or upper case.
+func genHex(rng *rand.Rand) input {
+	f := randFloat(rng)
+	var s string
+	switch rng.Intn(4) {
+	case 0:
+		// Long mantissa with a random exponent.
+		b := []byte("0x")
+		for n := 1 + rng.Intn(30); n > 0; n-- {
+			b = append(b, "0123456789abcdef"[rng.Intn(16)])
+			if rng.Intn(10) == 0 {
+				b = append(b, '.')
+			}
+		}
+		s = string(b) + "p" + strconv.Itoa(rng.Intn(2400)-1200)
+	default:
+		s = strconv.FormatFloat(f, 'x', rng.Intn(17)-1, 64)
+	}
+	if rng.Intn(6) == 0 {
+		s = strings.ToUpper(s)
+	}
+	if rng.Intn(6) == 0 {
+		i := rng.Intn(len(s) + 1)
+		s = s[:i] + "_" + s[i:]
+	}
+	return input{s: s}
+}
+
+// genInt returns an integer in a random base from 2 to 36, or with a
+// prefix for base 0, sometimes overflowing or with a stray byte.
+func genInt(rng *rand.Rand) input {
+	base := 2 + rng.Intn(35)
+	u := rng.Uint64() >> rng.Intn(64)
+	var s string
+	if rng.Intn(2) == 0 {
+		s = strconv.FormatInt(int64(u), base)
+	} else {
+		s = strconv.FormatUint(u, base)
+	}
+	switch rng.Intn(8) {
+	case 0: // overflow
+		s += strconv.FormatUint(rng.Uint64()%uint64(base), base)
+	case 1: // stray byte
+		i := rng.Intn(len(s) + 1)
+		s = s[:i] + string("+-_ .xz9\x00\xff"[rng.Intn(10)]) + s[i:]
+	case 2: // base prefix
+		prefix := [...]struct {
+			p    string
+			base int
+		}{{"0x", 16}, {"0X", 16}, {"0o", 8}, {"0", 8}, {"0b", 2}, {"0B", 2}}[rng.Intn(6)]
+		s = prefix.p + strconv.FormatUint(u, prefix.base)
+		if rng.Intn(2) == 0 && len(s) > 3 {
+			i := 2 + rng.Intn(len(s)-2)
+			s = s[:i] + "_" + s[i:]
+		}
+		base = 0
+	case 3:
+		s = strings.ToUpper(s)
+	}
+	if rng.Intn(10) == 0 && s != "" && s[0] != '-' {
+		s = "+" + s
+	}
+	return input{s: s, base: base}
+}
+
+// genQuote returns a string mixing ASCII, quotes and backslashes, escape
+// sequences, control characters, multi-byte runes and invalid UTF-8.
+func genQuote(rng *rand.Rand) input {
+	n := rng.Intn(40)
+	if rng.Intn(8) == 0 {
+		n = rng.Intn(400)
+	}
+	var b []byte
+	for len(b) < n {
+		switch k := rng.Intn(20); {
+		case k < 10:
+			b = append(b, byte(' '+rng.Intn(95)))
+		case k == 10:
+			b = append(b, "\"'`\\"[rng.Intn(4)])
+		case k == 11:
+			b = append(b, []string{`\n`, `\x41`, `\377`, `é`, `\U0001F600`, `\'`, `\"`, `\q`, `\u`, `\xZZ`}[rng.Intn(10)]...)
+		case k == 12:
+			b = append(b, byte(rng.Intn(32)), 0x7F)
+		case k == 13:
+			b = append(b, byte(0x80+rng.Intn(128)))
+		case k == 14:
+			b = append(b, "\xed\xa0\x80"...) // surrogate
+		case k < 17:
+			b = utf8.AppendRune(b, rune(0x80+rng.Intn(0x3000)))
+		default:
+			b = utf8.AppendRune(b, rune(rng.Intn(utf8.MaxRune+1)))
+		}
+	}
+	return input{s: string(b)}
+}
+
+// readTestfp returns the inputs of a testfp.txt file, turning its binary
+// notation, mantissa "p" exponent, into a hexadecimal float.
+func readTestfp(name string) ([]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var cases []string
+	s := bufio.NewScanner(f)
+	for lineno := 1; s.Scan(); lineno++ {
+		line := s.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		a := strings.Split(line, " ")
+		if len(a) != 4 {
+			return nil, fmt.Errorf("%s:%d: wrong field count", name, lineno)
+		}
+		in := a[2]
+		if mant, exp, ok := strings.Cut(in, "p"); ok {
+			neg := strings.HasPrefix(mant, "-")
+			m, err := strconv.ParseUint(strings.TrimPrefix(mant, "-"), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: bad input %q", name, lineno, in)
+			}
+			in = "0x" + strconv.FormatUint(m, 16) + "p" + exp
+			if neg {
+				in = "-" + in
+			}
+		}
+		cases = append(cases, in)
+	}
+	return cases, s.Err()
+}
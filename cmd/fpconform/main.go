@@ -0,0 +1,276 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Fpconform checks that every fastparse implementation agrees with strconv.
+//
+// It feeds inputs from a set of generators to the exported parse, format
+// and quote functions that have a strconv counterpart, once under each
+// implementation the CPU supports (forced with fastparse.SetImplementation),
+// and compares every result and error with strconv's. Mismatching inputs
+// are shrunk to a small reproducer before they are reported.
+//
+// Usage:
+//
+//	fpconform [flags]
+//
+// The flags are:
+//
+//	-duration d
+//		Stop generating inputs after d (default 10s). The testfp cases
+//		are always run in full.
+//	-seed n
+//		Seed the generators with n instead of the current time.
+//	-impl list
+//		Only test the comma-separated implementations, e.g. Generic,AVX2.
+//	-gen list
+//		Only run the comma-separated generators: bits, halfway, long,
+//		testfp, hex, int and quote.
+//	-testfp file
+//		Read the testfp cases from file (default testdata/testfp.txt).
+//	-max n
+//		Report at most n mismatches per function (default 5).
+//	-v
+//		Print progress for each generator.
+//
+// Fpconform exits with status 1 if any implementation disagrees with
+// strconv and 2 on a usage error. The AVX2 implementation covers the BMI2
+// and FMA kernels, which have no level of their own.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mshafiee/fastparse"
+	"github.com/mshafiee/fastparse/internal/dispatch"
+)
+
+var (
+	duration   = flag.Duration("duration", 10*time.Second, "time budget for the random generators")
+	seed       = flag.Int64("seed", 0, "generator seed (default: current time)")
+	implFlag   = flag.String("impl", "", "comma-separated implementations to test (default: all supported)")
+	genFlag    = flag.String("gen", "", "comma-separated generators to run (default: all)")
+	testfpFile = flag.String("testfp", "testdata/testfp.txt", "testfp cases `file`")
+	maxReport  = flag.Int("max", 5, "mismatches reported per function")
+	verbose    = flag.Bool("v", false, "print progress")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: fpconform [flags]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 0 {
+		usage()
+	}
+	if !flagSet("seed") {
+		*seed = time.Now().UnixNano()
+	}
+
+	impls, err := dispatch.ParseLevels[fastparse.Implementation](*implFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fpconform: %v\n", err)
+		os.Exit(2)
+	}
+	gens, err := generators(*genFlag, *seed)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fpconform: %v\n", err)
+		os.Exit(2)
+	}
+
+	orig := fastparse.Features().Current
+	r := newRunner(impls)
+	r.run(gens, time.Now().Add(*duration))
+	fastparse.SetImplementation(orig)
+
+	fmt.Printf("fpconform: seed %d, implementations %s\n", *seed, joinImpls(impls))
+	for _, g := range gens {
+		fmt.Printf("  %-8s %9d inputs\n", g.name, r.inputs[g.name])
+	}
+	if len(r.mismatches) == 0 {
+		fmt.Printf("PASS: %d checks, no divergence from strconv\n", r.checks)
+		return
+	}
+	r.report(os.Stdout)
+	os.Exit(1)
+}
+
+// flagSet reports whether the named flag was given on the command line.
+func flagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+func joinImpls(impls []fastparse.Implementation) string {
+	names := make([]string, len(impls))
+	for i, impl := range impls {
+		names[i] = impl.String()
+	}
+	return strings.Join(names, ",")
+}
+
+// A mismatch is an input on which a function of some implementations
+// disagrees with strconv.
+type mismatch struct {
+	check     string
+	input     string // shrunk input
+	original  string // input as generated
+	base      int
+	impls     []fastparse.Implementation
+	got, want string
+}
+
+// A runner runs the checks of each input under every implementation and
+// collects the mismatches.
+type runner struct {
+	impls      []fastparse.Implementation
+	inputs     map[string]int
+	checks     int
+	mismatches map[string]*mismatch // by check and shrunk input
+	perCheck   map[string]int       // mismatches per check
+}
+
+func newRunner(impls []fastparse.Implementation) *runner {
+	return &runner{
+		impls:      impls,
+		inputs:     make(map[string]int),
+		mismatches: make(map[string]*mismatch),
+		perCheck:   make(map[string]int),
+	}
+}
+
+// run takes inputs from each generator in turn until deadline, then drains
+// the generators that are finite.
+func (r *runner) run(gens []*generator, deadline time.Time) {
+	for live := len(gens); live > 0; {
+		live = 0
+		for _, g := range gens {
+			if g.done || (!g.finite && time.Now().After(deadline)) {
+				continue
+			}
+			in, ok := g.next()
+			if !ok {
+				g.done = true
+				if *verbose {
+					fmt.Fprintf(os.Stderr, "fpconform: %s: done after %d inputs\n", g.name, r.inputs[g.name])
+				}
+				continue
+			}
+			live++
+			r.inputs[g.name]++
+			r.test(g, in)
+			if *verbose && r.inputs[g.name]%100000 == 0 {
+				fmt.Fprintf(os.Stderr, "fpconform: %s: %d inputs\n", g.name, r.inputs[g.name])
+			}
+		}
+	}
+}
+
+// test runs every check of g on in under each implementation.
+func (r *runner) test(g *generator, in input) {
+	for _, impl := range r.impls {
+		fastparse.SetImplementation(impl)
+		for _, c := range g.checks {
+			r.checks++
+			got, want := c.run(in)
+			if got == want {
+				continue
+			}
+			r.record(c, impl, in)
+		}
+	}
+}
+
+// record shrinks a failing input and adds it to the mismatches.
+func (r *runner) record(c *check, impl fastparse.Implementation, in input) {
+	small := shrink(c, in)
+	key := c.name + "\x00" + small.s
+	m := r.mismatches[key]
+	if m == nil {
+		got, want := c.run(small)
+		m = &mismatch{check: c.name, input: small.s, original: in.s, base: in.base, got: got, want: want}
+		r.mismatches[key] = m
+		r.perCheck[c.name]++
+	}
+	for _, have := range m.impls {
+		if have == impl {
+			return
+		}
+	}
+	m.impls = append(m.impls, impl)
+}
+
+// shrink returns a shorter input that c still fails on under the current
+// implementation, removing ever smaller chunks of the input while the
+// failure persists.
+func shrink(c *check, in input) input {
+	const maxTries = 5000
+	fails := func(s string) bool {
+		got, want := c.run(input{s: s, base: in.base})
+		return got != want
+	}
+	s, tries := in.s, 0
+	for size := len(s) / 2; size >= 1 && tries < maxTries; size /= 2 {
+		for i := 0; i+size <= len(s) && tries < maxTries; {
+			tries++
+			if t := s[:i] + s[i+size:]; fails(t) {
+				s = t
+				continue
+			}
+			i++
+		}
+	}
+	return input{s: s, base: in.base}
+}
+
+// report prints the mismatches, grouped by function.
+func (r *runner) report(w *os.File) {
+	ms := make([]*mismatch, 0, len(r.mismatches))
+	for _, m := range r.mismatches {
+		ms = append(ms, m)
+	}
+	sort.Slice(ms, func(i, j int) bool {
+		if ms[i].check != ms[j].check {
+			return ms[i].check < ms[j].check
+		}
+		if len(ms[i].input) != len(ms[j].input) {
+			return len(ms[i].input) < len(ms[j].input)
+		}
+		return ms[i].input < ms[j].input
+	})
+	fmt.Fprintf(w, "FAIL: %d mismatches with strconv\n", len(ms))
+	shown := make(map[string]int)
+	for _, m := range ms {
+		if shown[m.check]++; shown[m.check] > *maxReport {
+			if shown[m.check] == *maxReport+1 {
+				fmt.Fprintf(w, "\n%s: %d more not shown\n", m.check, r.perCheck[m.check]-*maxReport)
+			}
+			continue
+		}
+		fmt.Fprintf(w, "\n%s [%s]\n", m.check, joinImpls(m.impls))
+		fmt.Fprintf(w, "  input:     %q", m.input)
+		if m.base != 0 {
+			fmt.Fprintf(w, " (base %d)", m.base)
+		}
+		fmt.Fprintln(w)
+		if m.original != m.input {
+			fmt.Fprintf(w, "  generated: %q\n", m.original)
+		}
+		fmt.Fprintf(w, "  fastparse: %s\n", m.got)
+		fmt.Fprintf(w, "  strconv:   %s\n", m.want)
+	}
+}
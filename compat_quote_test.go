@@ -12,11 +12,12 @@ import (
 	"github.com/mshafiee/fastparse"
 )
 
-// Verify that our IsPrint agrees with unicode.IsPrint.
+// Verify that our IsPrint agrees with the vendored Unicode data.
 func TestIsPrintStdlib(t *testing.T) {
+	isPrint, _ := fastparse.ReadUCD(t)
 	n := 0
 	for r := rune(0); r <= unicode.MaxRune; r++ {
-		if fastparse.IsPrint(r) != unicode.IsPrint(r) {
+		if fastparse.IsPrint(r) != isPrint(r) {
 			t.Errorf("IsPrint(%U)=%t incorrect", r, fastparse.IsPrint(r))
 			n++
 			if n > 10 {
@@ -26,11 +27,12 @@ func TestIsPrintStdlib(t *testing.T) {
 	}
 }
 
-// Verify that our IsGraphic agrees with unicode.IsGraphic.
+// Verify that our IsGraphic agrees with the vendored Unicode data.
 func TestIsGraphicStdlib(t *testing.T) {
+	_, isGraphic := fastparse.ReadUCD(t)
 	n := 0
 	for r := rune(0); r <= unicode.MaxRune; r++ {
-		if fastparse.IsGraphic(r) != unicode.IsGraphic(r) {
+		if fastparse.IsGraphic(r) != isGraphic(r) {
 			t.Errorf("IsGraphic(%U)=%t incorrect", r, fastparse.IsGraphic(r))
 			n++
 			if n > 10 {
@@ -17,6 +17,14 @@ var (
 	globalBuf [64]byte
 	nextToOne = "1.00000000000000011102230246251565404236316680908203125" + strings.Repeat("0", 10000) + "1"
 
+	// Inputs for the multiprecision slow path: more digits than a decimal
+	// holds, and the exact halfway point between 0 and the smallest
+	// subnormal float64, nudged either way by a digit far beyond it.
+	manyNines          = strings.Repeat("9", 1000) + "e-1000"
+	halfSubnormal      = "2.4703282292062327208828439643411068618252990130716238221279284125033775363510437593264991818081799618989828234772285886546332835517796989819938739800539093906315035659515570226392290858392449105184435931802849936536152500319370457678249219365623669863658480757001585769269903706311928279558551332927834338409351978015531246597263579574622766465272827220056374006485499977096599470454020828166226237857393450736339007967761930577506740176324673600968951340535537458516661134223766678604162159680461914467291840300530057530849048765391711386591646239524912623653881879636239373280423891018672348497668235089863388587925628302755995657524455507255189313690836254779186948667994968324049705821028513185451396213837722826145437693412532098591327667236328125e-324"
+	aboveHalfSubnormal = strings.Replace(halfSubnormal, "125e-324", "125"+strings.Repeat("0", 1000)+"1e-324", 1)
+	belowHalfSubnormal = strings.Replace(halfSubnormal, "125e-324", "124"+strings.Repeat("9", 1000)+"e-324", 1)
+
 	mallocTest = []struct {
 		count int
 		desc  string
@@ -43,6 +51,21 @@ var (
 		{0, `ParseFloat("1.0000000000000001110223024625156540423631668090820312500...001", 64)`, func() {
 			fastparse.ParseFloat(nextToOne, 64)
 		}},
+		{0, `ParseFloat("999...9e-1000", 64)`, func() { fastparse.ParseFloat(manyNines, 64) }},
+		{0, `ParseFloat("2.4703282292062327...125e-324", 64)`, func() { fastparse.ParseFloat(halfSubnormal, 64) }},
+		{0, `ParseFloat("2.4703282292062327...125000...001e-324", 64)`, func() { fastparse.ParseFloat(aboveHalfSubnormal, 64) }},
+		{0, `ParseFloat("2.4703282292062327...124999...999e-324", 32)`, func() { fastparse.ParseFloat(belowHalfSubnormal, 32) }},
+		// parseFloatGeneric is ParseFloat's bitSize 64 path outside amd64;
+		// test its FSA tier and multiprecision fallback everywhere.
+		{0, `parseFloatGeneric("1_000.5")`, func() { fastparse.ParseFloatGeneric("1_000.5") }},
+		{0, `parseFloatGeneric("Infinity")`, func() { fastparse.ParseFloatGeneric("Infinity") }},
+		{0, `parseFloatGeneric("1.0000000000000001110223024625156540423631668090820312500...001")`, func() {
+			fastparse.ParseFloatGeneric(nextToOne)
+		}},
+		{0, `parseFloatGeneric("999...9e-1000")`, func() { fastparse.ParseFloatGeneric(manyNines) }},
+		{0, `parseFloatGeneric("2.4703282292062327...125e-324")`, func() { fastparse.ParseFloatGeneric(halfSubnormal) }},
+		{0, `parseFloatGeneric("2.4703282292062327...125000...001e-324")`, func() { fastparse.ParseFloatGeneric(aboveHalfSubnormal) }},
+		{0, `parseFloatGeneric("2.4703282292062327...124999...999e-324")`, func() { fastparse.ParseFloatGeneric(belowHalfSubnormal) }},
 	}
 )
 
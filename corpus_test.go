@@ -0,0 +1,151 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+//go:generate go run mkcorpus.go -version v1
+
+import (
+	"bufio"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// floatCorpusVersion is the version of testdata/fpcorpus the tests read.
+const floatCorpusVersion = "v1"
+
+// readFloatCorpus returns the fields of each case in the named file of the
+// float corpus written by mkcorpus.go.
+func readFloatCorpus(tb testing.TB, name string) [][]string {
+	tb.Helper()
+	path := filepath.Join("testdata", "fpcorpus", floatCorpusVersion, name)
+	f, err := os.Open(path)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer f.Close()
+	var cases [][]string
+	s := bufio.NewScanner(f)
+	s.Buffer(nil, 1<<20)
+	for lineno := 1; s.Scan(); lineno++ {
+		line := s.Text()
+		if lineno == 1 && !strings.HasPrefix(line, "# fastparse float corpus "+floatCorpusVersion+":") {
+			tb.Fatalf("%s: not a %s corpus file", path, floatCorpusVersion)
+		}
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		cases = append(cases, strings.Fields(line))
+	}
+	if err := s.Err(); err != nil {
+		tb.Fatal(err)
+	}
+	return cases
+}
+
+func corpusBitSize(tb testing.TB, typ string) int {
+	switch typ {
+	case "float64":
+		return 64
+	case "float32":
+		return 32
+	}
+	tb.Fatalf("bad corpus type %q", typ)
+	return 0
+}
+
+// corpusFloat reads an expected value, written as a hexadecimal float.
+func corpusFloat(tb testing.TB, s string, bitSize int) float64 {
+	f, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		tb.Fatalf("bad corpus value %q: %v", s, err)
+	}
+	return f
+}
+
+func TestCorpusParseFloat(t *testing.T) {
+	cases := readFloatCorpus(t, "atof.txt")
+	for _, impl := range supportedImplementations() {
+		withImplementation(t, impl, func(t *testing.T) {
+			for _, c := range cases {
+				bitSize := corpusBitSize(t, c[0])
+				in, want := c[1], corpusFloat(t, c[2], bitSize)
+				got, err := ParseFloat(in, bitSize)
+				if math.Float64bits(got) != math.Float64bits(want) {
+					t.Errorf("ParseFloat(%s, %d) = %v (%s), want %v (%s)", in, bitSize,
+						got, strconv.FormatFloat(got, 'x', -1, bitSize), want, c[2])
+				}
+				if overflow := math.IsInf(want, 0); overflow != errors.Is(err, ErrRange) || !overflow && err != nil {
+					t.Errorf("ParseFloat(%s, %d): err = %v", in, bitSize, err)
+				}
+			}
+		})
+	}
+}
+
+// TryParseFloat takes a different path from ParseFloat on some platforms,
+// so check that the two agree on the corpus inputs too long for a uint64
+// mantissa, where the slow paths take over.
+func TestCorpusTryParseFloat(t *testing.T) {
+	for _, c := range readFloatCorpus(t, "atof.txt") {
+		bitSize := corpusBitSize(t, c[0])
+		in := c[1]
+		if len(in) <= 20 {
+			continue
+		}
+		want, err := ParseFloat(in, bitSize)
+		got, st := TryParseFloat(in, bitSize)
+		if math.Float64bits(got) != math.Float64bits(want) || st != errStatus(err) {
+			t.Errorf("TryParseFloat(%s, %d) = %v, %v; ParseFloat gives %v, %v", in, bitSize, got, st, want, err)
+		}
+	}
+}
+
+func TestCorpusAppendFloat(t *testing.T) {
+	cases := readFloatCorpus(t, "ftoa.txt")
+	for _, impl := range supportedImplementations() {
+		withImplementation(t, impl, func(t *testing.T) {
+			for _, c := range cases {
+				bitSize := corpusBitSize(t, c[0])
+				f := corpusFloat(t, c[1], bitSize)
+				prec, err := strconv.Atoi(c[3])
+				if err != nil || len(c[2]) != 1 {
+					t.Fatalf("bad corpus case %q", c)
+				}
+				got := AppendFloat([]byte("x="), f, c[2][0], prec, bitSize)
+				if want := "x=" + c[4]; string(got) != want {
+					t.Errorf("AppendFloat(%s, %s, %d, %d) = %s, want %s", c[1], c[2], prec, bitSize, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCorpusParseComplex(t *testing.T) {
+	cases := readFloatCorpus(t, "complex.txt")
+	for _, impl := range supportedImplementations() {
+		withImplementation(t, impl, func(t *testing.T) {
+			for _, c := range cases {
+				bitSize, err := strconv.Atoi(c[0])
+				if err != nil {
+					t.Fatalf("bad corpus case %q", c)
+				}
+				in := c[1]
+				wantRe, wantIm := corpusFloat(t, c[2], bitSize/2), corpusFloat(t, c[3], bitSize/2)
+				got, err := ParseComplex(in, bitSize)
+				if math.Float64bits(real(got)) != math.Float64bits(wantRe) || math.Float64bits(imag(got)) != math.Float64bits(wantIm) {
+					t.Errorf("ParseComplex(%s, %d) = %v, want (%v+%vi)", in, bitSize, got, wantRe, wantIm)
+				}
+				if overflow := math.IsInf(wantRe, 0) || math.IsInf(wantIm, 0); overflow != errors.Is(err, ErrRange) || !overflow && err != nil {
+					t.Errorf("ParseComplex(%s, %d): err = %v", in, bitSize, err)
+				}
+			}
+		})
+	}
+}
@@ -6,61 +6,30 @@
 
 package fastparse
 
-import (
-	"sync"
-)
+import "github.com/mshafiee/fastparse/internal/dispatch"
 
-// CPU feature flags
-var (
-	hasAVX2     bool
-	hasAVX512F  bool
-	hasAVX512BW bool
-	hasAVX512DQ bool
-	hasBMI2     bool
-	onceInit    sync.Once
-)
+// The Has functions report whether fastparse runs kernels using a feature:
+// the CPU must support it and the implementation in force, as set by
+// [SetImplementation] or FASTPARSE_DISABLE, must allow it. [Features]
+// reports what the hardware supports regardless.
 
-// detectCPUFeatures uses CPUID to detect CPU capabilities
-//
-//go:noescape
-func cpuidAMD64(eaxIn, ecxIn uint32) (eax, ebx, ecx, edx uint32)
-
-func initCPUFeatures() {
-	// Check for AVX2 and BMI2 support
-	// CPUID.(EAX=07H, ECX=0H):EBX
-	_, ebx, _, _ := cpuidAMD64(7, 0)
-	hasAVX2 = (ebx & (1 << 5)) != 0
-	hasBMI2 = (ebx & (1 << 8)) != 0
-
-	// Check for AVX-512 support
-	// AVX512F (Foundation) - bit 16
-	// AVX512BW (Byte/Word) - bit 30
-	// AVX512DQ (Doubleword/Quadword) - bit 17
-	hasAVX512F = (ebx & (1 << 16)) != 0
-	hasAVX512DQ = (ebx & (1 << 17)) != 0
-	hasAVX512BW = (ebx & (1 << 30)) != 0
-}
-
-// HasAVX2 returns true if the CPU supports AVX2 instructions
+// HasAVX2 reports whether the AVX2 kernels are in use.
 func HasAVX2() bool {
-	onceInit.Do(initCPUFeatures)
-	return hasAVX2
+	return dispatch.HasAVX2 && dispatch.Allow(dispatch.AVX2)
 }
 
-// HasAVX512 returns true if the CPU supports AVX-512F instructions
+// HasAVX512 reports whether the AVX-512F kernels are in use.
 func HasAVX512() bool {
-	onceInit.Do(initCPUFeatures)
-	return hasAVX512F
+	return dispatch.HasAVX512F && dispatch.Allow(dispatch.AVX512)
 }
 
-// HasAVX512BW returns true if the CPU supports AVX-512 Byte/Word instructions
+// HasAVX512BW reports whether the AVX-512 Byte/Word kernels are in use.
 func HasAVX512BW() bool {
-	onceInit.Do(initCPUFeatures)
-	return hasAVX512BW
+	return dispatch.HasAVX512BW && dispatch.Allow(dispatch.AVX512)
 }
 
-// HasBMI2 returns true if the CPU supports BMI2 instructions
+// HasBMI2 reports whether the BMI2 kernels, part of the AVX2 level, are in
+// use.
 func HasBMI2() bool {
-	onceInit.Do(initCPUFeatures)
-	return hasBMI2
+	return dispatch.HasBMI2 && dispatch.Allow(dispatch.AVX2)
 }
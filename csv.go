@@ -0,0 +1,286 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/mshafiee/fastparse/internal/quoting"
+)
+
+// AppendCSVField appends s to dst as an RFC 4180 CSV field separated by
+// sep and returns the extended buffer. The field is quoted only if it
+// contains sep, a double quote, CR or LF, and each double quote in a
+// quoted field is doubled. It panics if sep is not ASCII or is a double
+// quote, CR or LF.
+func AppendCSVField(dst []byte, s string, sep byte) []byte {
+	checkCSVSeparator(sep)
+	return quoting.AppendCSV(dst, s, sep)
+}
+
+func checkCSVSeparator(sep byte) {
+	if sep >= 0x80 || sep == '"' || sep == '\r' || sep == '\n' {
+		panic("fastparse: invalid CSV separator")
+	}
+}
+
+// These are the errors a CSVReader reports, inside a [CSVError], for
+// malformed input. They match the errors of encoding/csv.
+var (
+	ErrCSVBareQuote  = errors.New("bare \" in non-quoted-field")
+	ErrCSVQuote      = errors.New("extraneous or missing \" in quoted-field")
+	ErrCSVFieldCount = errors.New("wrong number of fields")
+)
+
+// A CSVError reports a malformed record, or a field of the current record
+// that a typed accessor of [CSVReader] could not convert.
+type CSVError struct {
+	Record int   // record number, starting at 1
+	Field  int   // field index, starting at 0
+	Offset int64 // byte offset in the input where the field starts
+	Err    error // the underlying error, such as ErrCSVQuote or a *NumError
+}
+
+func (e *CSVError) Error() string {
+	return "fastparse.CSVReader: record " + Itoa(e.Record) + ", field " + Itoa(e.Field) +
+		" (offset " + FormatInt(e.Offset, 10) + "): " + e.Err.Error()
+}
+
+func (e *CSVError) Unwrap() error { return e.Err }
+
+// A CSVReader reads records from RFC 4180 CSV input.
+//
+// Records are returned as [][]byte views of an internal buffer, so reading
+// does not allocate per record or per field once the buffers have grown
+// to fit. Quoted fields are unquoted in place. Empty lines are skipped, a
+// trailing CR before LF is dropped, and CRLF inside a quoted field reads
+// as LF, as in encoding/csv.
+//
+// The typed accessors convert a field of the most recently read record
+// without copying it.
+type CSVReader struct {
+	// Comma is the field separator. NewCSVReader sets it to ','. It must
+	// be ASCII and not a double quote, CR or LF.
+	Comma byte
+
+	r      *bufio.Reader
+	line   []byte   // raw input of the current record
+	bounds []int    // start and end of each field value in line
+	starts []int    // start of each raw field in line
+	fields [][]byte // the record returned by Read
+	record int      // number of the current record
+	offset int64    // input offset of line[0]
+	next   int64    // input offset of the next unread byte
+}
+
+// NewCSVReader returns a CSVReader reading from r.
+func NewCSVReader(r io.Reader) *CSVReader {
+	return &CSVReader{Comma: ',', r: bufio.NewReader(r)}
+}
+
+// Read reads the next record. The record, and every slice the accessors
+// return, is only valid until the next call to Read. At the end of the
+// input Read returns nil, [io.EOF]. A malformed record is reported as a
+// *[CSVError], after which reading continues with the next line.
+func (r *CSVReader) Read() (record [][]byte, err error) {
+	checkCSVSeparator(r.Comma)
+	r.fields = r.fields[:0]
+	for {
+		r.offset = r.next
+		r.line = r.line[:0]
+		if err := r.readLine(); err != nil {
+			return nil, err
+		}
+		if n := len(r.line); n > 1 && !(n == 2 && r.line[0] == '\r') {
+			break
+		}
+	}
+	r.record++
+	if err := r.parseRecord(); err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(r.bounds); i += 2 {
+		r.fields = append(r.fields, r.line[r.bounds[i]:r.bounds[i+1]])
+	}
+	return r.fields, nil
+}
+
+// readLine appends the next line of input, with its LF, to r.line. A last
+// line without one gets one added. It returns io.EOF if there is no input.
+func (r *CSVReader) readLine() error {
+	n := len(r.line)
+	for {
+		b, err := r.r.ReadSlice('\n')
+		r.line = append(r.line, b...)
+		r.next += int64(len(b))
+		switch {
+		case err == bufio.ErrBufferFull:
+			continue
+		case err == io.EOF && len(r.line) > n:
+			r.line = append(r.line, '\n')
+		case err != nil:
+			return err
+		}
+		return nil
+	}
+}
+
+// parseRecord splits r.line into fields, reading further lines while a
+// quoted field is open.
+func (r *CSVReader) parseRecord() error {
+	r.bounds = r.bounds[:0]
+	r.starts = r.starts[:0]
+	special := quoting.CSVSpecial(r.Comma)
+	for pos := 0; ; {
+		r.starts = append(r.starts, pos)
+		var end, next int // end of the value, and of the raw field
+		if r.line[pos] != '"' {
+			end = pos
+			for {
+				end += special.Index(bytesToString(r.line[end:]))
+				switch c := r.line[end]; {
+				case c == '"':
+					return r.syntaxError(end, ErrCSVBareQuote)
+				case c == '\r' && r.line[end+1] != '\n':
+					end++ // a CR inside a field is data
+					continue
+				}
+				break
+			}
+			next = end
+		} else {
+			var err error
+			if end, next, err = r.parseQuoted(pos); err != nil {
+				return err
+			}
+			pos++
+		}
+		r.bounds = append(r.bounds, pos, end)
+		if r.line[next] != r.Comma {
+			return nil // at CR LF or LF
+		}
+		pos = next + 1
+	}
+}
+
+// parseQuoted unquotes the quoted field starting at r.line[pos] in place.
+// It returns the end of the value, which starts at pos+1, and of the raw
+// field.
+func (r *CSVReader) parseQuoted(pos int) (end, next int, err error) {
+	start := pos + 1
+	w := start // the unquoted value is written back to line[start:w]
+	for i := start; ; {
+		j := bytes.IndexByte(r.line[i:], '"')
+		if j < 0 {
+			// The field goes on past this line.
+			w += copy(r.line[w:], r.line[i:])
+			i = len(r.line)
+			if err := r.readLine(); err != nil {
+				if err == io.EOF {
+					return 0, 0, r.syntaxError(pos, ErrCSVQuote)
+				}
+				return 0, 0, err
+			}
+			continue
+		}
+		w += copy(r.line[w:], r.line[i:i+j])
+		i += j + 1
+		switch c := r.line[i]; {
+		case c == '"':
+			r.line[w] = '"'
+			w++
+			i++
+			continue
+		case c == r.Comma || c == '\n' || c == '\r' && r.line[i+1] == '\n':
+			// CRLF in a quoted field reads as LF.
+			if k := bytes.Index(r.line[start:w], crlf); k >= 0 {
+				k += start
+				for j := k; j < w; j++ {
+					if r.line[j] != '\r' || j+1 == w || r.line[j+1] != '\n' {
+						r.line[k] = r.line[j]
+						k++
+					}
+				}
+				w = k
+			}
+			return w, i, nil
+		}
+		return 0, 0, r.syntaxError(i, ErrCSVQuote)
+	}
+}
+
+var crlf = []byte("\r\n")
+
+func (r *CSVReader) syntaxError(at int, err error) error {
+	return &CSVError{Record: r.record, Field: len(r.starts) - 1, Offset: r.offset + int64(at), Err: err}
+}
+
+// Len returns the number of fields in the current record.
+func (r *CSVReader) Len() int {
+	return len(r.fields)
+}
+
+// Unquoted returns field i of the current record with any quoting
+// removed. It panics if i is out of range.
+func (r *CSVReader) Unquoted(i int) []byte {
+	return r.fields[i]
+}
+
+// field returns field i of the current record as a string sharing its
+// memory, or an error if the record is too short.
+func (r *CSVReader) field(i int) (string, error) {
+	if i < 0 || i >= len(r.fields) {
+		return "", &CSVError{Record: r.record, Field: i, Offset: r.offset + int64(len(r.line)), Err: ErrCSVFieldCount}
+	}
+	return bytesToString(r.fields[i]), nil
+}
+
+// fieldError wraps err, returned for field i, in a *CSVError.
+func (r *CSVReader) fieldError(i int, err error) error {
+	return &CSVError{Record: r.record, Field: i, Offset: r.offset + int64(r.starts[i]), Err: err}
+}
+
+// Int64 returns field i of the current record parsed by [ParseInt] in
+// base 10.
+func (r *CSVReader) Int64(i int) (int64, error) {
+	s, err := r.field(i)
+	if err != nil {
+		return 0, err
+	}
+	v, err := ParseInt(s, 10, 64)
+	if err != nil {
+		return v, r.fieldError(i, err)
+	}
+	return v, nil
+}
+
+// Float64 returns field i of the current record parsed by [ParseFloat].
+func (r *CSVReader) Float64(i int) (float64, error) {
+	s, err := r.field(i)
+	if err != nil {
+		return 0, err
+	}
+	v, err := ParseFloat(s, 64)
+	if err != nil {
+		return v, r.fieldError(i, err)
+	}
+	return v, nil
+}
+
+// Bool returns field i of the current record parsed by [ParseBool].
+func (r *CSVReader) Bool(i int) (bool, error) {
+	s, err := r.field(i)
+	if err != nil {
+		return false, err
+	}
+	v, err := ParseBool(s)
+	if err != nil {
+		return v, r.fieldError(i, err)
+	}
+	return v, nil
+}
@@ -0,0 +1,263 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mshafiee/fastparse"
+)
+
+var appendCSVFieldTests = []struct {
+	in  string
+	sep byte
+	out string
+}{
+	{"", ',', ``},
+	{"abc", ',', `abc`},
+	{" lead", ',', ` lead`},
+	{"a,b", ',', `"a,b"`},
+	{"a,b", ';', `a,b`},
+	{"a;b", ';', `"a;b"`},
+	{"a\tb", '\t', "\"a\tb\""},
+	{`say "hi"`, ',', `"say ""hi"""`},
+	{`"`, ',', `""""`},
+	{"two\nlines", ',', "\"two\nlines\""},
+	{"cr\r", ',', "\"cr\r\""},
+	{"a long field with no special bytes in it at all", ',', "a long field with no special bytes in it at all"},
+	{"a long field with a comma, near the end of it", ',', "\"a long field with a comma, near the end of it\""},
+	{"café", ',', "café"},
+}
+
+func TestAppendCSVField(t *testing.T) {
+	for _, tt := range appendCSVFieldTests {
+		if out := string(fastparse.AppendCSVField([]byte("x"), tt.in, tt.sep)); out != "x"+tt.out {
+			t.Errorf("AppendCSVField(%q, %q) = %q, want %q", tt.in, tt.sep, out[1:], tt.out)
+		}
+	}
+}
+
+func TestAppendCSVFieldPanics(t *testing.T) {
+	for _, sep := range []byte{'"', '\r', '\n', 0x80, 0xff} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("AppendCSVField with separator %q did not panic", sep)
+				}
+			}()
+			fastparse.AppendCSVField(nil, "a", sep)
+		}()
+	}
+}
+
+// readCSV reads all of in with a CSVReader, copying each record.
+func readCSV(in string, comma byte) ([][]string, error) {
+	r := fastparse.NewCSVReader(strings.NewReader(in))
+	r.Comma = comma
+	var out [][]string
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return out, err
+		}
+		fields := make([]string, len(rec))
+		for i, f := range rec {
+			fields[i] = string(f)
+		}
+		out = append(out, fields)
+	}
+}
+
+// stdReadCSV reads all of in with encoding/csv.
+func stdReadCSV(in string, comma byte) ([][]string, error) {
+	r := csv.NewReader(strings.NewReader(in))
+	r.Comma = rune(comma)
+	r.FieldsPerRecord = -1
+	return r.ReadAll()
+}
+
+var csvReaderTests = []string{
+	"",
+	"\n\n\r\n",
+	"a,b,c\n",
+	"a,b,c",
+	"a,b,c\r\nd,e,f\r\n",
+	",,\n",
+	"a,\n,b\n",
+	`"a","b,c","d""e"` + "\n",
+	`"",""` + "\n",
+	"\"multi\nline\",x\n",
+	"\"crlf\r\ninside\",x\r\n",
+	"lone\rcr,x\n",
+	"x,\"quoted at end\"",
+	"a\n\nb\n\n\nc\n",
+	" lead, trail \n",
+	"café,über\n",
+	"\"a\"\"\"\"b\"\n",
+}
+
+func TestCSVReader(t *testing.T) {
+	for _, in := range csvReaderTests {
+		got, err := readCSV(in, ',')
+		if err != nil {
+			t.Errorf("read %q: %v", in, err)
+			continue
+		}
+		want, _ := stdReadCSV(in, ',')
+		if len(got) != 0 || len(want) != 0 {
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("read %q = %q, want %q", in, got, want)
+			}
+		}
+	}
+}
+
+func TestCSVReaderRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	const alphabet = "ab, ;\t\"\r\né"
+	runes := []rune(alphabet)
+	for n := 0; n < 2000; n++ {
+		comma := []byte{',', ';', '\t'}[r.Intn(3)]
+		var buf []byte
+		var records [][]string
+		for i := r.Intn(5) + 1; i > 0; i-- {
+			var rec []string
+			for j := r.Intn(6) + 1; j > 0; j-- {
+				f := make([]rune, r.Intn(60))
+				for k := range f {
+					f[k] = runes[r.Intn(len(runes))]
+				}
+				rec = append(rec, string(f))
+			}
+			for j, f := range rec {
+				if j > 0 {
+					buf = append(buf, comma)
+				}
+				buf = fastparse.AppendCSVField(buf, f, comma)
+			}
+			buf = append(buf, "\n\r\n"[r.Intn(2):]...)
+			records = append(records, rec)
+		}
+		got, err := readCSV(string(buf), comma)
+		want, wantErr := stdReadCSV(string(buf), comma)
+		if (err == nil) != (wantErr == nil) {
+			t.Fatalf("read %q: err = %v, encoding/csv err = %v", buf, err, wantErr)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("read %q = %q, encoding/csv = %q", buf, got, want)
+		}
+	}
+}
+
+var csvErrorTests = []struct {
+	in     string
+	record int
+	field  int
+	offset int64
+	err    error
+}{
+	{`a,b"c` + "\n", 1, 1, 3, fastparse.ErrCSVBareQuote},
+	{"x\n\n" + `a,"b"c` + "\n", 2, 1, 8, fastparse.ErrCSVQuote},
+	{"x,y\n" + `"a`, 2, 0, 4, fastparse.ErrCSVQuote},
+	{"x\n\"a\nb\"c\n", 2, 0, 7, fastparse.ErrCSVQuote},
+}
+
+func TestCSVReaderErrors(t *testing.T) {
+	for _, tt := range csvErrorTests {
+		_, err := readCSV(tt.in, ',')
+		var e *fastparse.CSVError
+		if !errors.As(err, &e) {
+			t.Errorf("read %q: err = %v, want *CSVError", tt.in, err)
+			continue
+		}
+		if e.Record != tt.record || e.Field != tt.field || e.Offset != tt.offset || e.Err != tt.err {
+			t.Errorf("read %q: err = %v, want record %d, field %d (offset %d): %v",
+				tt.in, err, tt.record, tt.field, tt.offset, tt.err)
+		}
+		if !errors.Is(err, tt.err) {
+			t.Errorf("read %q: errors.Is(%v, %v) = false", tt.in, err, tt.err)
+		}
+	}
+}
+
+func TestCSVReaderAccessors(t *testing.T) {
+	r := fastparse.NewCSVReader(strings.NewReader("id,price,ok\n42,\"1.5\",true\n7,x,maybe\n"))
+	if _, err := r.Read(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Read(); err != nil {
+		t.Fatal(err)
+	}
+	if r.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", r.Len())
+	}
+	if v, err := r.Int64(0); v != 42 || err != nil {
+		t.Errorf("Int64(0) = %d, %v, want 42, nil", v, err)
+	}
+	if v, err := r.Float64(1); v != 1.5 || err != nil {
+		t.Errorf("Float64(1) = %g, %v, want 1.5, nil", v, err)
+	}
+	if v, err := r.Bool(2); !v || err != nil {
+		t.Errorf("Bool(2) = %t, %v, want true, nil", v, err)
+	}
+	if s := string(r.Unquoted(1)); s != "1.5" {
+		t.Errorf("Unquoted(1) = %q, want %q", s, "1.5")
+	}
+	_, err := r.Int64(3)
+	var e *fastparse.CSVError
+	if !errors.As(err, &e) || e.Err != fastparse.ErrCSVFieldCount || e.Record != 2 || e.Field != 3 {
+		t.Errorf("Int64(3) err = %v, want record 2, field 3: %v", err, fastparse.ErrCSVFieldCount)
+	}
+
+	if _, err := r.Read(); err != nil {
+		t.Fatal(err)
+	}
+	_, err = r.Float64(1)
+	var ne *fastparse.NumError
+	if !errors.As(err, &e) || e.Record != 3 || e.Field != 1 || e.Offset != 28 || !errors.As(err, &ne) || ne.Err != fastparse.ErrSyntax {
+		t.Errorf("Float64(1) err = %v, want record 3, field 1 (offset 28) wrapping a syntax error", err)
+	}
+	if _, err := r.Bool(2); !errors.Is(err, fastparse.ErrSyntax) {
+		t.Errorf("Bool(2) err = %v, want %v", err, fastparse.ErrSyntax)
+	}
+}
+
+func TestCSVReaderAllocs(t *testing.T) {
+	var buf bytes.Buffer
+	for i := 0; i < 1000; i++ {
+		buf.WriteString("12345,\"quoted, \"\"field\"\"\",3.25,true\n")
+	}
+	data := buf.Bytes()
+	br := bytes.NewReader(data)
+	r := fastparse.NewCSVReader(br)
+	if _, err := r.Read(); err != nil {
+		t.Fatal(err)
+	}
+	allocs := testing.AllocsPerRun(500, func() {
+		rec, err := r.Read()
+		if err != nil || len(rec) != 4 {
+			t.Fatalf("Read() = %q, %v", rec, err)
+		}
+		if _, err := r.Int64(0); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := r.Float64(2); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Read allocates %v times per record, want 0", allocs)
+	}
+}
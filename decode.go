@@ -0,0 +1,488 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"encoding"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DecodeOptions configures Decode. The zero value is ready to use.
+type DecodeOptions struct {
+	// Tag is the struct tag key read for field options, "fastparse" if empty.
+	Tag string
+
+	// Prefix is prepended to every key, such as "APP_" for environment
+	// variables.
+	Prefix string
+
+	// Separator joins the key of a nested struct field to the keys of its
+	// fields, "." if empty.
+	Separator string
+
+	// Locale is the locale of number fields without a locale option.
+	Locale string
+}
+
+// ErrFieldRequired is the error a FieldError reports for a required field
+// whose key src does not have.
+var ErrFieldRequired = errors.New("required field missing")
+
+// A FieldError reports a struct field Decode could not set.
+type FieldError struct {
+	Path string // the field's path in dst, such as "Server.Port"
+	Key  string // the key looked up, such as "server.port"
+	Err  error  // ErrFieldRequired, or the parser's error, such as a *NumError
+}
+
+func (e *FieldError) Error() string {
+	return "fastparse: field " + e.Path + " (key " + QuoteToASCII(e.Key) + "): " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error { return e.Err }
+
+// A DecodeError reports every field Decode could not set, in field order.
+// errors.Is and errors.As look through it into each FieldError.
+type DecodeError struct {
+	Fields []*FieldError
+}
+
+func (e *DecodeError) Error() string {
+	var b strings.Builder
+	for i, f := range e.Fields {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(f.Error())
+	}
+	return b.String()
+}
+
+func (e *DecodeError) Unwrap() []error {
+	errs := make([]error, len(e.Fields))
+	for i, f := range e.Fields {
+		errs[i] = f
+	}
+	return errs
+}
+
+// Decode sets the fields of the struct dst points to from the string
+// values src returns for their keys, converting them with the fastparse
+// parsers. It suits config loaders and environment binders, with src
+// os.LookupEnv, and HTTP handlers, with src [LookupValues] of a query or
+// form. A field whose key src does not have keeps its value, unless the
+// field has a default.
+//
+// Decode sets fields of type string, bool, the integer and float types,
+// time.Duration, types implementing [encoding.TextUnmarshaler], such as
+// [Float64] and time.Time, and pointers to these, which it allocates only
+// to set them. It parses integers with [ParseInt] or [ParseUint], floats
+// with [ParseFloat], bools with [ParseBool] and durations with
+// time.ParseDuration. It descends into nested and embedded structs,
+// without a key of their own for an embedded struct unless its tag names
+// one, and skips unexported fields.
+//
+// A field's tag holds its key and then options, separated by commas:
+//
+//	Port    uint16        `fastparse:"port,required"`
+//	Mask    uint32        `fastparse:"mask,base=16,default=ffffff00"`
+//	Ratio   float64       `fastparse:"ratio,locale=de,default=0,5"`
+//	Timeout time.Duration `fastparse:",default=30s"`
+//	Secret  string        `fastparse:"-"`
+//
+// An empty key is the field's name; "-" skips the field, while "-," gives
+// it the key "-", as in encoding/json. The options are
+//
+//	required     report ErrFieldRequired if src has no value and there is no default
+//	base=N       the base for ParseInt and ParseUint, 10 by default; 0 accepts prefixes
+//	bitSize=N    a bit size to check integers and round floats to, below the field's
+//	locale=L     a number locale: en, de, es, fr, it, nl, pt, de-CH
+//	default=S    the value used if src has none; the rest of the tag, commas and all
+//
+// A locale sets the decimal and group separators of numbers: under de,
+// "1.234,5" is 1234.5. Group separators are dropped wherever they appear.
+//
+// Decode returns an error without setting any field if dst is not a
+// non-nil pointer to a struct, or if a field's type or tag is invalid.
+// Otherwise it sets every field it can and returns a *[DecodeError]
+// listing the fields it could not, each wrapping the parser's error.
+// Decode analyzes each struct type once for each DecodeOptions and caches
+// the result, so later calls cost the lookups and conversions alone.
+func Decode(dst any, src func(key string) (string, bool), opts DecodeOptions) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		t := "nil"
+		if dst != nil {
+			t = rv.Type().String()
+		}
+		return errors.New("fastparse: Decode needs a non-nil pointer to a struct, not " + t)
+	}
+	plan := cachedDecodePlan(rv.Type().Elem(), opts)
+	if plan.err != nil {
+		return plan.err
+	}
+
+	v := rv.Elem()
+	var errs []*FieldError
+	for i := range plan.fields {
+		f := &plan.fields[i]
+		s, ok := src(f.key)
+		if !ok {
+			if !f.hasDefault {
+				if f.required {
+					errs = append(errs, &FieldError{f.path, f.key, ErrFieldRequired})
+				}
+				continue
+			}
+			s = f.def
+		}
+		if err := f.set(v.FieldByIndex(f.index), s); err != nil {
+			errs = append(errs, &FieldError{f.path, f.key, err})
+		}
+	}
+	if errs != nil {
+		return &DecodeError{errs}
+	}
+	return nil
+}
+
+// LookupMap returns a Decode source looking keys up in m.
+func LookupMap(m map[string]string) func(key string) (string, bool) {
+	return func(key string) (string, bool) {
+		s, ok := m[key]
+		return s, ok
+	}
+}
+
+// LookupValues returns a Decode source looking keys up in v, such as a
+// url.Values, and taking the first value of each.
+func LookupValues(v map[string][]string) func(key string) (string, bool) {
+	return func(key string) (string, bool) {
+		if vs := v[key]; len(vs) > 0 {
+			return vs[0], true
+		}
+		return "", false
+	}
+}
+
+// A decodeKind is the conversion Decode applies to a field.
+type decodeKind uint8
+
+const (
+	decodeString decodeKind = iota
+	decodeBool
+	decodeInt
+	decodeUint
+	decodeFloat
+	decodeDuration
+	decodeText
+)
+
+// A decodeField is a settable field of a struct type, with its options.
+type decodeField struct {
+	index      []int  // for reflect.Value.FieldByIndex
+	path       string // Go field path, for errors
+	key        string // source key, including the prefix
+	def        string
+	hasDefault bool
+	required   bool
+	kind       decodeKind
+	ptr        bool // the field is a pointer to the kind
+	base       int
+	bitSize    int
+	locale     *numberLocale
+}
+
+// A decodePlan is the analysis of a struct type, or the error that it
+// cannot be decoded.
+type decodePlan struct {
+	fields []decodeField
+	err    error
+}
+
+type decodePlanKey struct {
+	t    reflect.Type
+	opts DecodeOptions
+}
+
+var decodePlans sync.Map // decodePlanKey → *decodePlan
+
+func cachedDecodePlan(t reflect.Type, opts DecodeOptions) *decodePlan {
+	key := decodePlanKey{t, opts}
+	if p, ok := decodePlans.Load(key); ok {
+		return p.(*decodePlan)
+	}
+	if opts.Tag == "" {
+		opts.Tag = "fastparse"
+	}
+	if opts.Separator == "" {
+		opts.Separator = "."
+	}
+	p := new(decodePlan)
+	if opts.Locale != "" && locales[opts.Locale] == nil {
+		p.err = errors.New("fastparse: Decode: unknown locale " + QuoteToASCII(opts.Locale))
+	} else {
+		p.err = p.add(t, nil, "", opts.Prefix, &opts)
+	}
+	if p.err != nil {
+		p.fields = nil
+	}
+	actual, _ := decodePlans.LoadOrStore(key, p)
+	return actual.(*decodePlan)
+}
+
+var (
+	durationType        = reflect.TypeFor[time.Duration]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// add appends the fields of struct type t, found at index in the
+// outermost struct, with Go paths and keys beginning path and key.
+func (p *decodePlan) add(t reflect.Type, index []int, path, key string, opts *DecodeOptions) error {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, options := sf.Name, ""
+		tag, tagged := sf.Tag.Lookup(opts.Tag)
+		if tagged {
+			var comma bool
+			name, options, comma = strings.Cut(tag, ",")
+			if name == "-" && !comma {
+				continue
+			}
+			if name == "" {
+				name = sf.Name
+			}
+		}
+		f := decodeField{
+			index:   append(index[:len(index):len(index)], i),
+			path:    path + sf.Name,
+			key:     key + name,
+			base:    10,
+			bitSize: -1,
+		}
+
+		ft := sf.Type
+		if ft.Kind() == reflect.Pointer {
+			f.ptr = true
+			ft = ft.Elem()
+		}
+		nested := ft.Kind() == reflect.Struct && ft != durationType &&
+			!reflect.PointerTo(ft).Implements(textUnmarshalerType)
+		if !sf.IsExported() {
+			// The exported fields of an embedded struct are promoted
+			// even when the struct's type is unexported.
+			if !sf.Anonymous || !nested || f.ptr {
+				continue
+			}
+		}
+		if nested {
+			switch {
+			case f.ptr:
+				return p.fieldError(&f, "unsupported type "+sf.Type.String())
+			case options != "":
+				return p.fieldError(&f, "options on a struct field")
+			}
+			var err error
+			if sf.Anonymous && name == sf.Name {
+				err = p.add(ft, f.index, path, key, opts)
+			} else {
+				err = p.add(ft, f.index, f.path+".", f.key+opts.Separator, opts)
+			}
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		switch {
+		case ft == durationType:
+			f.kind = decodeDuration
+		case reflect.PointerTo(ft).Implements(textUnmarshalerType):
+			f.kind = decodeText
+		default:
+			switch ft.Kind() {
+			case reflect.String:
+				f.kind = decodeString
+			case reflect.Bool:
+				f.kind = decodeBool
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				f.kind = decodeInt
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+				f.kind = decodeUint
+			case reflect.Float32, reflect.Float64:
+				f.kind = decodeFloat
+			default:
+				return p.fieldError(&f, "unsupported type "+sf.Type.String())
+			}
+		}
+		if err := f.parseOptions(options, opts); err != nil {
+			return p.fieldError(&f, err.Error())
+		}
+		if f.bitSize < 0 {
+			f.bitSize = 0
+			if f.kind == decodeInt || f.kind == decodeUint || f.kind == decodeFloat {
+				f.bitSize = ft.Bits()
+			}
+		} else if f.bitSize > ft.Bits() {
+			return p.fieldError(&f, "bitSize "+Itoa(f.bitSize)+" exceeds "+ft.String())
+		}
+		p.fields = append(p.fields, f)
+	}
+	return nil
+}
+
+func (p *decodePlan) fieldError(f *decodeField, msg string) error {
+	return errors.New("fastparse: Decode: field " + f.path + ": " + msg)
+}
+
+// parseOptions sets the options of f from the part of its tag after the key.
+func (f *decodeField) parseOptions(options string, opts *DecodeOptions) error {
+	number := f.kind == decodeInt || f.kind == decodeUint || f.kind == decodeFloat
+	if opts.Locale != "" && number {
+		f.locale = locales[opts.Locale]
+	}
+	for options != "" {
+		var opt string
+		if strings.HasPrefix(options, "default=") {
+			opt, options = options, ""
+		} else {
+			opt, options, _ = strings.Cut(options, ",")
+		}
+		name, value, hasValue := strings.Cut(opt, "=")
+		switch {
+		case name == "required" && !hasValue:
+			f.required = true
+		case name == "default" && hasValue:
+			f.def, f.hasDefault = value, true
+		case name == "base" && hasValue && (f.kind == decodeInt || f.kind == decodeUint):
+			base, st := TryParseInt(value, 10, 0)
+			if st != StatusOK || base != 0 && (base < 2 || base > 36) {
+				return errors.New("invalid base " + QuoteToASCII(value))
+			}
+			f.base = int(base)
+		case name == "bitSize" && hasValue && number:
+			bitSize, st := TryParseInt(value, 10, 0)
+			if st != StatusOK || bitSize < 1 || f.kind == decodeFloat && bitSize != 32 && bitSize != 64 {
+				return errors.New("invalid bitSize " + QuoteToASCII(value))
+			}
+			f.bitSize = int(bitSize)
+		case name == "locale" && hasValue && number:
+			if f.locale = locales[value]; f.locale == nil {
+				return errors.New("unknown locale " + QuoteToASCII(value))
+			}
+		default:
+			return errors.New("invalid option " + QuoteToASCII(opt))
+		}
+	}
+	return nil
+}
+
+// set converts s and stores it in v, the field f.
+func (f *decodeField) set(v reflect.Value, s string) error {
+	if f.ptr {
+		p := reflect.New(v.Type().Elem())
+		if err := f.setValue(p.Elem(), s); err != nil {
+			return err
+		}
+		v.Set(p)
+		return nil
+	}
+	return f.setValue(v, s)
+}
+
+func (f *decodeField) setValue(v reflect.Value, s string) error {
+	switch f.kind {
+	case decodeString:
+		v.SetString(s)
+	case decodeBool:
+		b, err := ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case decodeInt:
+		n, err := ParseInt(f.locale.normalize(s), f.base, f.bitSize)
+		if err != nil {
+			return withInput(err, s)
+		}
+		v.SetInt(n)
+	case decodeUint:
+		n, err := ParseUint(f.locale.normalize(s), f.base, f.bitSize)
+		if err != nil {
+			return withInput(err, s)
+		}
+		v.SetUint(n)
+	case decodeFloat:
+		x, err := ParseFloat(f.locale.normalize(s), f.bitSize)
+		if err != nil {
+			return withInput(err, s)
+		}
+		v.SetFloat(x)
+	case decodeDuration:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+	case decodeText:
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	return nil
+}
+
+// withInput puts s back into err if it is the *NumError of a parser given
+// the normalized form of s, so that the error quotes the text src gave.
+func withInput(err error, s string) error {
+	if e, ok := err.(*NumError); ok {
+		e.Num = strings.Clone(s)
+	}
+	return err
+}
+
+// A numberLocale gives the separators of numbers written for a locale.
+type numberLocale struct {
+	decimal byte     // the decimal separator
+	groups  []string // the digit group separators
+}
+
+var (
+	localeDot   = &numberLocale{'.', []string{","}}
+	localeComma = &numberLocale{',', []string{"."}}
+)
+
+var locales = map[string]*numberLocale{
+	"en":    localeDot,
+	"de":    localeComma,
+	"es":    localeComma,
+	"it":    localeComma,
+	"nl":    localeComma,
+	"pt":    localeComma,
+	"fr":    {',', []string{" ", "\u00a0", "\u202f"}},
+	"de-CH": {'.', []string{"'", "\u2019"}},
+}
+
+// normalize returns s, a number written for locale l, as the parsers
+// expect it: without group separators and with a '.' decimal separator.
+// A nil locale returns s unchanged.
+func (l *numberLocale) normalize(s string) string {
+	if l == nil {
+		return s
+	}
+	for _, g := range l.groups {
+		if strings.Contains(s, g) {
+			s = strings.ReplaceAll(s, g, "")
+		}
+	}
+	if l.decimal != '.' {
+		if i := strings.IndexByte(s, l.decimal); i >= 0 {
+			s = s[:i] + "." + s[i+1:]
+		}
+	}
+	return s
+}
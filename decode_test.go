@@ -0,0 +1,219 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type decodeServer struct {
+	Host string `fastparse:"host,default=localhost"`
+	Port uint16 `fastparse:"port,required"`
+}
+
+type DecodeCommon struct {
+	Debug bool `fastparse:"debug"`
+}
+
+type decodeConfig struct {
+	DecodeCommon
+	Name     string
+	Server   decodeServer  `fastparse:"server"`
+	Mask     uint32        `fastparse:"mask,base=16,default=ffffff00"`
+	Offset   int64         `fastparse:"offset,base=0"`
+	Small    int           `fastparse:"small,bitSize=8"`
+	Ratio    float64       `fastparse:"ratio,locale=de,default=0,5"`
+	Total    float32       `fastparse:"total"`
+	Timeout  time.Duration `fastparse:"timeout,default=30s"`
+	Limit    *int          `fastparse:"limit"`
+	Retries  *int          `fastparse:"retries"`
+	Start    *time.Time    `fastparse:"start"`
+	Typed    Float64       `fastparse:"typed"`
+	Secret   string        `fastparse:"-"`
+	Dash     string        `fastparse:"-,"`
+	internal int
+}
+
+func TestDecode(t *testing.T) {
+	src := map[string]string{
+		"debug":       "true",
+		"Name":        "api",
+		"server.port": "8080",
+		"offset":      "-0x10",
+		"small":       "-128",
+		"total":       "1.5",
+		"limit":       "100",
+		"start":       "2025-01-02T03:04:05Z",
+		"typed":       "0x1p-2",
+		"Secret":      "ignored",
+		"-":           "dash",
+	}
+	c := decodeConfig{Secret: "kept", internal: 7}
+	if err := Decode(&c, LookupMap(src), DecodeOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	if !c.Debug || c.Name != "api" || c.Server.Host != "localhost" || c.Server.Port != 8080 ||
+		c.Mask != 0xffffff00 || c.Offset != -16 || c.Small != -128 || c.Ratio != 0.5 || c.Total != 1.5 ||
+		c.Timeout != 30*time.Second || c.Limit == nil || *c.Limit != 100 || c.Retries != nil ||
+		c.Start == nil || !c.Start.Equal(start) || c.Typed != 0.25 || c.Secret != "kept" || c.Dash != "dash" || c.internal != 7 {
+		t.Errorf("Decode = %+v", c)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	src := map[string]string{
+		"server.port": "70000",
+		"small":       "200",
+		"mask":        "xyz",
+		"timeout":     "soon",
+		"ratio":       "1.234,5",
+	}
+	var c decodeConfig
+	err := Decode(&c, LookupMap(src), DecodeOptions{})
+	var derr *DecodeError
+	if !errors.As(err, &derr) {
+		t.Fatalf("Decode: err = %v, want a *DecodeError", err)
+	}
+	var paths []string
+	for _, f := range derr.Fields {
+		paths = append(paths, f.Path)
+	}
+	if got, want := strings.Join(paths, " "), "Server.Port Mask Small Timeout"; got != want {
+		t.Errorf("failed fields %q, want %q", got, want)
+	}
+	if !errors.Is(err, ErrRange) || !errors.Is(err, ErrSyntax) {
+		t.Errorf("Decode error %v does not wrap ErrRange and ErrSyntax", err)
+	}
+	var nerr *NumError
+	if !errors.As(err, &nerr) || nerr.Func != "ParseUint" || nerr.Num != "70000" {
+		t.Errorf("first NumError = %+v", nerr)
+	}
+	if want := `fastparse: field Server.Port (key "server.port"): strconv.ParseUint: parsing "70000": value out of range`; derr.Fields[0].Error() != want {
+		t.Errorf("FieldError = %s\nwant %s", derr.Fields[0], want)
+	}
+	if c.Ratio != 1234.5 {
+		t.Errorf("Ratio = %v, want 1234.5 despite other errors", c.Ratio)
+	}
+
+	// Errors quote the number as given, not as normalized for its locale.
+	var loc struct {
+		N float64 `fastparse:"n,locale=de"`
+	}
+	err = Decode(&loc, LookupMap(map[string]string{"n": "1.234,5x"}), DecodeOptions{})
+	if !errors.As(err, &nerr) || nerr.Num != "1.234,5x" {
+		t.Errorf("Decode of locale number: NumError = %+v, want Num %q", nerr, "1.234,5x")
+	}
+
+	err = Decode(&c, LookupMap(nil), DecodeOptions{})
+	if !errors.As(err, &derr) || len(derr.Fields) != 1 || !errors.Is(err, ErrFieldRequired) || derr.Fields[0].Key != "server.port" {
+		t.Errorf("Decode of empty source: err = %v, want Server.Port required", err)
+	}
+}
+
+func TestDecodeOptions(t *testing.T) {
+	type env struct {
+		DB struct {
+			Host string `env:"HOST"`
+			Port int    `env:"PORT"`
+		} `env:"DB"`
+		Price float64 `env:"PRICE"`
+	}
+	vars := map[string]string{"APP_DB_HOST": "db", "APP_DB_PORT": "5432", "APP_PRICE": "1 234,50"}
+	var e env
+	err := Decode(&e, LookupMap(vars), DecodeOptions{Tag: "env", Prefix: "APP_", Separator: "_", Locale: "fr"})
+	if err != nil || e.DB.Host != "db" || e.DB.Port != 5432 || e.Price != 1234.5 {
+		t.Errorf("Decode = %+v, %v", e, err)
+	}
+
+	query := map[string][]string{"q": {"a", "b"}, "n": {"3"}, "empty": {}}
+	var form struct {
+		Q     string `fastparse:"q"`
+		N     uint8  `fastparse:"n"`
+		Empty string `fastparse:"empty,default=none"`
+	}
+	if err := Decode(&form, LookupValues(query), DecodeOptions{}); err != nil || form.Q != "a" || form.N != 3 || form.Empty != "none" {
+		t.Errorf("Decode = %+v, %v", form, err)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	var n int
+	var c decodeConfig
+	for _, dst := range []any{nil, c, &n, (*decodeConfig)(nil)} {
+		if err := Decode(dst, LookupMap(nil), DecodeOptions{}); err == nil {
+			t.Errorf("Decode(%T) succeeded", dst)
+		}
+	}
+	if err := Decode(&c, LookupMap(nil), DecodeOptions{Locale: "xx"}); err == nil {
+		t.Errorf("Decode with unknown locale succeeded")
+	}
+	tests := []struct {
+		dst  any
+		want string
+	}{
+		{&struct{ C complex128 }{}, "field C: unsupported type complex128"},
+		{&struct {
+			N int `fastparse:"n,base=1"`
+		}{}, `field N: invalid base "1"`},
+		{&struct {
+			N int8 `fastparse:"n,bitSize=16"`
+		}{}, "field N: bitSize 16 exceeds int8"},
+		{&struct {
+			F float64 `fastparse:"f,bitSize=16"`
+		}{}, `field F: invalid bitSize "16"`},
+		{&struct {
+			S string `fastparse:"s,locale=de"`
+		}{}, `field S: invalid option "locale=de"`},
+		{&struct {
+			N int `fastparse:"n,locale=xx"`
+		}{}, `field N: unknown locale "xx"`},
+		{&struct{ P *decodeServer }{}, "field P: unsupported type *fastparse.decodeServer"},
+	}
+	for _, test := range tests {
+		err := Decode(test.dst, LookupMap(nil), DecodeOptions{})
+		if err == nil || !strings.HasSuffix(err.Error(), test.want) {
+			t.Errorf("Decode(%T): err = %v, want %s", test.dst, err, test.want)
+		}
+	}
+}
+
+func TestNumberLocale(t *testing.T) {
+	tests := []struct{ locale, in, want string }{
+		{"en", "1,234,567.5", "1234567.5"},
+		{"de", "1.234.567,5", "1234567.5"},
+		{"fr", "1 234,5", "1234.5"},
+		{"de-CH", "1'234.5", "1234.5"},
+		{"de", "12", "12"},
+	}
+	for _, test := range tests {
+		if got := locales[test.locale].normalize(test.in); got != test.want {
+			t.Errorf("%s normalize(%q) = %q, want %q", test.locale, test.in, got, test.want)
+		}
+	}
+}
+
+func TestDecodeAllocs(t *testing.T) {
+	var c struct {
+		Server  decodeServer  `fastparse:"server"`
+		Small   int8          `fastparse:"small"`
+		Total   float64       `fastparse:"total"`
+		On      bool          `fastparse:"on,default=true"`
+		Timeout time.Duration `fastparse:"timeout,default=30s"`
+		Missing uint64        `fastparse:"missing"`
+	}
+	src := LookupMap(map[string]string{"server.host": "db", "server.port": "8080", "small": "12", "total": "2.5"})
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := Decode(&c, src, DecodeOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Decode: %v allocations, want 0", allocs)
+	}
+}
@@ -86,6 +86,11 @@
 // NumError format matches strconv.NumError exactly for drop-in compatibility.
 // Error messages are identical to strconv for seamless replacement.
 //
+// Building a NumError allocates. Loops that expect many invalid inputs can
+// call TryParseInt, TryParseUint, TryParseFloat or TryParseBool, or their
+// Bytes variants, which report failure as a Status and never allocate;
+// Status.Err builds the equivalent NumError when one is needed.
+//
 // # Safety
 //
 // The parsers are implemented to avoid heap allocations and to respect
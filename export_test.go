@@ -5,9 +5,14 @@
 
 package fastparse
 
+import "math"
+
 var (
-	BitSizeError = bitSizeError
-	BaseError    = baseError
+	BitSizeError      = bitSizeError
+	BaseError         = baseError
+	ReadFloatCorpus   = readFloatCorpus
+	ParseFloatGeneric = parseFloatGeneric
+	ReadUCD           = readUCD
 )
 
 func SetOptimize(b bool) bool {
@@ -33,3 +38,33 @@ func MulByLog2Log10(x int) int {
 func MulByLog10Log2(x int) int {
 	return mulByLog10Log2(x)
 }
+
+// ShortestRyu and ShortestSchubfach return the shortest decimal digits and
+// decimal point position of a finite f from each float64 backend.
+func ShortestRyu(buf []byte, f float64) ([]byte, int) {
+	mant, exp := float64Parts(f)
+	d := decimalSlice{d: buf}
+	ryuFtoaShortest(&d, mant, exp, &float64info)
+	return d.d[:d.nd], d.dp
+}
+
+func ShortestSchubfach(buf []byte, f float64) ([]byte, int) {
+	mant, exp := float64Parts(f)
+	d := decimalSlice{d: buf}
+	schubfachFtoa64(&d, mant, exp)
+	return d.d[:d.nd], d.dp
+}
+
+// float64Parts splits a finite, non-negative f into mant*2^exp the way
+// genericFtoa does.
+func float64Parts(f float64) (mant uint64, exp int) {
+	bits := math.Float64bits(f)
+	exp = int(bits>>float64info.mantbits) & (1<<float64info.expbits - 1)
+	mant = bits & (1<<float64info.mantbits - 1)
+	if exp == 0 {
+		exp++
+	} else {
+		mant |= 1 << float64info.mantbits
+	}
+	return mant, exp + float64info.bias - int(float64info.mantbits)
+}
@@ -9,6 +9,7 @@ package fastparse
 import (
 	"math"
 
+	"github.com/mshafiee/fastparse/internal/dispatch"
 	"github.com/mshafiee/fastparse/internal/eisel_lemire"
 )
 
@@ -58,6 +59,9 @@ func parseFloat(s string) (float64, error) {
 	if len(s) == 0 {
 		return 0, ErrSyntax
 	}
+	if !dispatch.Allow(dispatch.SSE) {
+		return parseFloatGeneric(s)
+	}
 
 	// Fast path for hex floats (5% of inputs, specialized parser)
 	if len(s) > 2 && len(s) < 64 {
@@ -67,6 +71,7 @@ func parseFloat(s string) (float64, error) {
 		}
 		if idx+1 < len(s) && s[idx] == '0' && (s[idx+1] == 'x' || s[idx+1] == 'X') {
 			if result, ok := parseHexFast(s); ok {
+				stat(statFloatHex)
 				return result, nil
 			}
 		}
@@ -77,6 +82,7 @@ func parseFloat(s string) (float64, error) {
 	if len(s) < 32 {
 		result, mantissa, exp, neg, ok := parseSimpleFast(s)
 		if ok && exp >= minEiselLemireExp {
+			stat(statFloatSimple)
 			return result, nil
 		}
 
@@ -89,6 +95,7 @@ func parseFloat(s string) (float64, error) {
 			// This bypasses the FSA overhead (50-80ns savings) - matching strconv's approach
 			// Only do this if exp is in Eisel-Lemire's valid range
 			if result, ok := eisel_lemire.TryParse(mantissa, exp); ok {
+				stat(statFloatSimpleEiselLemire)
 				if neg {
 					result = -result
 				}
@@ -104,6 +111,7 @@ func parseFloat(s string) (float64, error) {
 	// Fast path for long decimals (5-10% of inputs)
 	if len(s) >= 20 && len(s) <= 100 {
 		if result, ok := parseLongDecimalFast(s); ok {
+			stat(statFloatLongDecimal)
 			return result, nil
 		}
 	}
@@ -126,27 +134,30 @@ func parseInt(s string, bitSize int) (int64, error) {
 	// For simple integers, use optimized parsers based on CPU capabilities
 	if len(s) <= 19 {
 		// Try BMI2 path first (fastest overflow checking)
-		if HasBMI2() {
+		if dispatch.HasBMI2 && dispatch.Allow(dispatch.AVX2) {
 			if result, ok := parseIntBMI2(s, bitSize); ok {
 				return result, nil
 			}
 		}
 
 		// Try AVX-512 path (processes up to 16 digits in parallel)
-		if HasAVX512() && len(s) >= 4 {
+		if dispatch.Allow(dispatch.AVX512) && len(s) >= 4 {
 			if result, ok := parseIntAVX512(s, bitSize); ok {
 				return result, nil
 			}
 		}
 
 		// Try AVX2 path (processes up to 8 digits in parallel)
-		if HasAVX2() && len(s) >= 4 {
+		if dispatch.Allow(dispatch.AVX2) && len(s) >= 4 {
 			if result, ok := parseIntAVX2(s, bitSize); ok {
 				return result, nil
 			}
 		}
 
 		// Fall back to basic assembly fast path
+		if !dispatch.Allow(dispatch.SSE) {
+			return parseIntGeneric(s, bitSize)
+		}
 		if result, ok := parseIntFastAsm(s, bitSize); ok {
 			return result, nil
 		}
@@ -9,6 +9,7 @@ package fastparse
 import (
 	"math"
 
+	"github.com/mshafiee/fastparse/internal/dispatch"
 	"github.com/mshafiee/fastparse/internal/eisel_lemire"
 )
 
@@ -43,6 +44,9 @@ func parseFloat(s string) (float64, error) {
 	if len(s) == 0 {
 		return 0, ErrSyntax
 	}
+	if !dispatch.Allow(dispatch.NEON) {
+		return parseFloatGeneric(s)
+	}
 
 	// Fast path for hex floats (5% of inputs, specialized parser)
 	if len(s) > 2 && len(s) < 64 {
@@ -52,6 +56,7 @@ func parseFloat(s string) (float64, error) {
 		}
 		if idx+1 < len(s) && s[idx] == '0' && (s[idx+1] == 'x' || s[idx+1] == 'X') {
 			if result, ok := parseHexFast(s); ok {
+				stat(statFloatHex)
 				return result, nil
 			}
 		}
@@ -61,12 +66,14 @@ func parseFloat(s string) (float64, error) {
 	// Assembly-optimized version for ARM64 with NEON
 	if len(s) < 32 {
 		if result, mantissa, exp, neg, ok := parseSimpleFast(s); ok {
+			stat(statFloatSimple)
 			return result, nil
 		} else if mantissa != 0 && exp >= -348 && exp <= 308 {
 			// parseSimpleFast parsed successfully but couldn't convert - try Eisel-Lemire directly!
 			// This bypasses the FSA overhead (50-80ns savings) - matching strconv's approach
 			// Only do this if exp is in Eisel-Lemire's valid range
 			if result, ok := eisel_lemire.TryParse(mantissa, exp); ok {
+				stat(statFloatSimpleEiselLemire)
 				if neg {
 					result = -result
 				}
@@ -82,6 +89,7 @@ func parseFloat(s string) (float64, error) {
 	// Fast path for long decimals (5-10% of inputs)
 	if len(s) >= 20 && len(s) <= 100 {
 		if result, ok := parseLongDecimalFast(s); ok {
+			stat(statFloatLongDecimal)
 			return result, nil
 		}
 	}
@@ -102,7 +110,7 @@ func parseInt(s string, bitSize int) (int64, error) {
 	}
 
 	// For simple integers, use NEON-optimized parser
-	if len(s) <= 19 {
+	if len(s) <= 19 && dispatch.Allow(dispatch.NEON) {
 		// Try NEON path first (processes up to 16 bytes in parallel)
 		if len(s) >= 4 {
 			if result, ok := parseIntNEON(s, bitSize); ok {
@@ -0,0 +1,98 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"errors"
+
+	"github.com/mshafiee/fastparse/internal/dispatch"
+)
+
+// An Implementation is a family of kernels that fastparse dispatches to.
+// On amd64 the implementations are ordered, each including the ones below
+// it; on arm64 there is only NEON above Generic.
+type Implementation uint8
+
+const (
+	Generic Implementation = Implementation(dispatch.Generic) // portable Go
+	SSE     Implementation = Implementation(dispatch.SSE)     // the baseline amd64 assembly, needing at most SSE2
+	AVX2    Implementation = Implementation(dispatch.AVX2)    // AVX2 kernels, with BMI2 and FMA where used
+	AVX512  Implementation = Implementation(dispatch.AVX512)  // AVX-512 kernels, needing AVX-512F and AVX-512BW
+	NEON    Implementation = Implementation(dispatch.NEON)    // the arm64 assembly
+)
+
+func (impl Implementation) String() string {
+	return dispatch.Level(impl).String()
+}
+
+// ErrUnsupportedImplementation is returned by [SetImplementation] for an
+// implementation the CPU cannot run.
+var ErrUnsupportedImplementation = errors.New("fastparse: implementation not supported by this CPU")
+
+// CPUFeatures describes the CPU features fastparse detected and the
+// implementation each subsystem dispatches to.
+type CPUFeatures struct {
+	// CPU features.
+	SSE2, AVX2, AVX512F, AVX512BW, BMI2, FMA, NEON bool
+
+	// Max is the best implementation the CPU supports. Current is the one
+	// in force, which SetImplementation and the FASTPARSE_DISABLE
+	// environment variable may lower.
+	Max, Current Implementation
+
+	// The implementation each subsystem runs under Current: the best of
+	// its kernels that Current allows.
+	IntParse   Implementation // ParseInt, ParseUint and Atoi
+	FloatParse Implementation // ParseFloat
+	Quoting    Implementation // Quote and the other quoting functions
+	Validation Implementation // ValidatePrint, ValidateGraphic and input checks
+	Itoa       Implementation // FormatInt, Itoa and the Append forms
+}
+
+// Features reports the detected CPU features and the implementation each
+// subsystem currently dispatches to.
+func Features() CPUFeatures {
+	f := CPUFeatures{
+		SSE2:     dispatch.HasSSE2,
+		AVX2:     dispatch.HasAVX2,
+		AVX512F:  dispatch.HasAVX512F,
+		AVX512BW: dispatch.HasAVX512BW,
+		BMI2:     dispatch.HasBMI2,
+		FMA:      dispatch.HasFMA,
+		NEON:     dispatch.HasNEON,
+		Max:      Implementation(dispatch.Max()),
+		Current:  Implementation(dispatch.Current()),
+	}
+	selectKernels(&f)
+	return f
+}
+
+// SetImplementation makes every subsystem dispatch to the best of its
+// kernels that impl allows, Generic turning all assembly off. It returns
+// [ErrUnsupportedImplementation] if the CPU cannot run impl. It is meant
+// to be called at start-up or to work around a misbehaving kernel, and
+// takes effect for calls that start after it returns.
+//
+// At init, fastparse reads FASTPARSE_DISABLE, a comma-separated list of
+// the features to turn off: avx512, avx2, sse, neon, or all. Turning off
+// a feature also turns off the implementations built on it, so avx2
+// leaves SSE in force on amd64.
+func SetImplementation(impl Implementation) error {
+	if !dispatch.Set(dispatch.Level(impl)) {
+		return ErrUnsupportedImplementation
+	}
+	return nil
+}
+
+// bestKernel returns the first of the implementations, given best first,
+// that current allows, or Generic.
+func bestKernel(current Implementation, kernels ...Implementation) Implementation {
+	for _, k := range kernels {
+		if k <= current {
+			return k
+		}
+	}
+	return Generic
+}
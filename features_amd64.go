@@ -0,0 +1,28 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64
+
+package fastparse
+
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
+// selectKernels fills in the implementation of each subsystem. It mirrors
+// the dispatch.Allow checks in front of the kernels.
+func selectKernels(f *CPUFeatures) {
+	// ParseInt and FormatInt are pure Go; the integer kernels are not on
+	// their paths.
+	f.IntParse = Generic
+	f.Itoa = Generic
+
+	// The decimal conversion uses FMA3 at the AVX2 level; the rest of the
+	// float kernels are baseline assembly.
+	if dispatch.HasFMA {
+		f.FloatParse = bestKernel(f.Current, AVX2, SSE)
+	} else {
+		f.FloatParse = bestKernel(f.Current, SSE)
+	}
+	f.Quoting = bestKernel(f.Current, AVX512, AVX2)
+	f.Validation = bestKernel(f.Current, AVX2, SSE)
+}
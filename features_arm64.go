@@ -0,0 +1,20 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64
+
+package fastparse
+
+// selectKernels fills in the implementation of each subsystem. It mirrors
+// the dispatch.Allow checks in front of the kernels.
+func selectKernels(f *CPUFeatures) {
+	// ParseInt and FormatInt are pure Go; the integer kernels are not on
+	// their paths.
+	f.IntParse = Generic
+	f.Itoa = Generic
+
+	f.FloatParse = bestKernel(f.Current, NEON)
+	f.Quoting = bestKernel(f.Current, NEON)
+	f.Validation = bestKernel(f.Current, NEON)
+}
@@ -0,0 +1,11 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 && !arm64
+
+package fastparse
+
+// selectKernels fills in the implementation of each subsystem, all of
+// which are portable Go on this architecture.
+func selectKernels(f *CPUFeatures) {}
@@ -0,0 +1,194 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mshafiee/fastparse/internal/dispatch"
+)
+
+// supportedImplementations returns the implementations this CPU can run.
+func supportedImplementations() []Implementation {
+	var impls []Implementation
+	for _, impl := range []Implementation{Generic, SSE, AVX2, AVX512, NEON} {
+		if dispatch.Supported(dispatch.Level(impl)) {
+			impls = append(impls, impl)
+		}
+	}
+	return impls
+}
+
+// withImplementation runs f with impl in force and restores the previous
+// implementation afterwards.
+func withImplementation(t *testing.T, impl Implementation, f func(t *testing.T)) {
+	t.Helper()
+	old := Features().Current
+	if err := SetImplementation(impl); err != nil {
+		t.Fatalf("SetImplementation(%v) = %v", impl, err)
+	}
+	defer SetImplementation(old)
+	t.Run(impl.String(), f)
+}
+
+func TestFeatures(t *testing.T) {
+	f := Features()
+	if f.Current != Generic && f.Current > f.Max {
+		t.Errorf("Current = %v above Max = %v", f.Current, f.Max)
+	}
+	for name, impl := range map[string]Implementation{
+		"IntParse":   f.IntParse,
+		"FloatParse": f.FloatParse,
+		"Quoting":    f.Quoting,
+		"Validation": f.Validation,
+		"Itoa":       f.Itoa,
+	} {
+		if impl > f.Current {
+			t.Errorf("%s = %v above Current = %v", name, impl, f.Current)
+		}
+	}
+	switch runtime.GOARCH {
+	case "amd64":
+		if f.Max < SSE || f.Max == NEON || f.NEON {
+			t.Errorf("Max = %v, NEON = %v on amd64", f.Max, f.NEON)
+		}
+		if f.AVX2 != (f.Max >= AVX2) {
+			t.Errorf("Max = %v, AVX2 = %v", f.Max, f.AVX2)
+		}
+	case "arm64":
+		if f.Max != Generic && f.Max != NEON {
+			t.Errorf("Max = %v on arm64", f.Max)
+		}
+	default:
+		if f.Max != Generic {
+			t.Errorf("Max = %v on %s", f.Max, runtime.GOARCH)
+		}
+	}
+}
+
+func TestSetImplementation(t *testing.T) {
+	old := Features().Current
+	defer SetImplementation(old)
+
+	if err := SetImplementation(Generic); err != nil {
+		t.Fatalf("SetImplementation(Generic) = %v", err)
+	}
+	f := Features()
+	if f.Current != Generic || f.FloatParse != Generic || f.Quoting != Generic || f.Validation != Generic {
+		t.Errorf("after SetImplementation(Generic): %+v", f)
+	}
+	if HasAVX2() || HasAVX512() {
+		t.Errorf("after SetImplementation(Generic): HasAVX2() = %v, HasAVX512() = %v", HasAVX2(), HasAVX512())
+	}
+
+	for _, impl := range []Implementation{SSE, AVX2, AVX512, NEON, Implementation(99)} {
+		err := SetImplementation(impl)
+		if dispatch.Supported(dispatch.Level(impl)) {
+			if err != nil {
+				t.Errorf("SetImplementation(%v) = %v", impl, err)
+			} else if got := Features().Current; got != impl {
+				t.Errorf("SetImplementation(%v): Current = %v", impl, got)
+			} else if HasAVX2() != (dispatch.HasAVX2 && impl >= AVX2 && impl != NEON) {
+				t.Errorf("SetImplementation(%v): HasAVX2() = %v", impl, HasAVX2())
+			}
+		} else if err != ErrUnsupportedImplementation {
+			t.Errorf("SetImplementation(%v) = %v, want ErrUnsupportedImplementation", impl, err)
+		}
+	}
+}
+
+// TestImplementationsAgree runs the float, quoting and validation tests
+// under every implementation the CPU supports.
+func TestImplementationsAgree(t *testing.T) {
+	long := strings.Repeat("hello, \"world\"\té☺ ", 20)
+	quoteInputs := []string{"", "abc", "a\x00b", "\xff", long, long + "\x7f", strings.Repeat("x", 100)}
+	for _, impl := range supportedImplementations() {
+		withImplementation(t, impl, func(t *testing.T) {
+			testAtof(t, true)
+			for _, s := range quoteInputs {
+				if got, want := Quote(s), strconv.Quote(s); got != want {
+					t.Errorf("Quote(%q) = %s, want %s", s, got, want)
+				}
+				if got, want := QuoteToASCII(s), strconv.QuoteToASCII(s); got != want {
+					t.Errorf("QuoteToASCII(%q) = %s, want %s", s, got, want)
+				}
+			}
+			for _, test := range validatePrintTests {
+				validate := ValidatePrint
+				if test.graphic {
+					validate = ValidateGraphic
+				}
+				ok, off := validate(test.in)
+				bad := badUTF8At(test.in, off)
+				if ok != test.ok || off != test.badOffset || bad != test.invalidUTF8 {
+					t.Errorf("validate(%q, graphic=%v) = %v, %d, %v; want %v, %d, %v",
+						test.in, test.graphic, ok, off, bad, test.ok, test.badOffset, test.invalidUTF8)
+				}
+			}
+			for _, s := range []string{"0", "-9223372036854775808", "18446744073709551615", "12345678901234567890123"} {
+				got, gotErr := ParseUint(s, 10, 64)
+				want, wantErr := strconv.ParseUint(s, 10, 64)
+				if got != want || (gotErr == nil) != (wantErr == nil) {
+					t.Errorf("ParseUint(%q) = %d, %v; want %d, %v", s, got, gotErr, want, wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestDisableSpec(t *testing.T) {
+	x86 := runtime.GOARCH != "arm64"
+	for _, test := range []struct {
+		spec     string
+		x86, arm dispatch.Level
+	}{
+		{"", dispatch.AVX512, dispatch.NEON},
+		{"avx512", dispatch.AVX2, dispatch.NEON},
+		{"AVX2", dispatch.SSE, dispatch.NEON},
+		{"avx512,avx2", dispatch.SSE, dispatch.NEON},
+		{"sse", dispatch.Generic, dispatch.NEON},
+		{"neon", dispatch.AVX512, dispatch.Generic},
+		{"avx2 neon", dispatch.SSE, dispatch.Generic},
+		{"all", dispatch.Generic, dispatch.Generic},
+		{"simd", dispatch.Generic, dispatch.Generic},
+		{"bogus", dispatch.AVX512, dispatch.NEON},
+	} {
+		want := test.arm
+		if x86 {
+			want = test.x86
+		}
+		if got := dispatch.Disable(test.spec); got != want {
+			t.Errorf("Disable(%q) = %v, want %v", test.spec, got, want)
+		}
+	}
+}
+
+// TestDisableEnv checks that FASTPARSE_DISABLE is honoured at init by
+// running this test binary again with it set.
+func TestDisableEnv(t *testing.T) {
+	if os.Getenv("FASTPARSE_DISABLE_CHILD") != "" {
+		if f := Features(); f.Current != Generic || f.FloatParse != Generic || f.Quoting != Generic {
+			t.Fatalf("FASTPARSE_DISABLE=all: %+v", f)
+		}
+		return
+	}
+	if testing.Short() {
+		t.Skip("skipping subprocess in short mode")
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		t.Skip(err)
+	}
+	cmd := exec.Command(exe, "-test.run=^TestDisableEnv$")
+	cmd.Env = append(os.Environ(), "FASTPARSE_DISABLE=all", "FASTPARSE_DISABLE_CHILD=1")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("child failed: %v\n%s", err, out)
+	}
+}
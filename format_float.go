@@ -11,7 +11,11 @@
 
 package fastparse
 
-import "math"
+import (
+	"math"
+
+	"github.com/mshafiee/fastparse/internal/ryu"
+)
 
 // TODO: move elsewhere?
 type floatInfo struct {
@@ -116,10 +120,15 @@ func genericFtoa(dst []byte, val float64, fmt byte, prec, bitSize int) []byte {
 	// Negative precision means "only as much as needed to be exact."
 	shortest := prec < 0
 	if shortest {
-		// Use Ryu algorithm.
+		// Use Schubfach for float64 unless built with fastparse_ryu,
+		// Ryu otherwise.
 		var buf [32]byte
 		digs.d = buf[:]
-		ryuFtoaShortest(&digs, mant, exp-int(flt.mantbits), flt)
+		if schubfachShortest && bitSize == 64 {
+			schubfachFtoa64(&digs, mant, exp-int(flt.mantbits))
+		} else {
+			ryuFtoaShortest(&digs, mant, exp-int(flt.mantbits), flt)
+		}
 		ok = true
 		// Precision for shortest representation mode.
 		switch fmt {
@@ -157,11 +166,44 @@ func genericFtoa(dst []byte, val float64, fmt byte, prec, bitSize int) []byte {
 		}
 	}
 	if !ok {
-		return bigFtoa(dst, prec, fmt, neg, mant, exp, flt)
+		return printfFtoa(dst, prec, fmt, neg, mant, exp, flt)
 	}
 	return formatDigits(dst, shortest, neg, digs, prec, fmt)
 }
 
+// printfFtoa formats a float with a fixed precision that the 64-bit Ryū
+// paths cannot produce: 'f' formats, and 'e' or 'g' with more than 18 digits.
+// Unlike bigFtoa it needs no multiprecision arithmetic.
+func printfFtoa(dst []byte, prec int, fmt byte, neg bool, mant uint64, exp int, flt *floatInfo) []byte {
+	digits := prec
+	kept := prec // upper bound on the digits FixedDigits keeps
+	switch fmt {
+	case 'e', 'E':
+		digits++
+		kept++
+	case 'f':
+		// The value is below 2^(exp+1): add its integer digits.
+		kept += max(mulByLog2Log10(exp+1)+1, 0)
+	case 'g', 'G':
+		if prec == 0 {
+			prec = 1
+		}
+		digits, kept = prec, prec
+	}
+	var digs decimalSlice
+	if kept <= 64 {
+		// Most requests are short: avoid clearing the full-size buffer.
+		var buf [64]byte
+		digs.d = buf[:]
+		digs.nd, digs.dp = ryu.FixedDigits(digs.d, mant, exp-int(flt.mantbits), digits, fmt == 'f')
+		return formatDigits(dst, false, neg, digs, prec, fmt)
+	}
+	var buf [ryu.MaxFixedDigits]byte
+	digs.d = buf[:]
+	digs.nd, digs.dp = ryu.FixedDigits(digs.d, mant, exp-int(flt.mantbits), digits, fmt == 'f')
+	return formatDigits(dst, false, neg, digs, prec, fmt)
+}
+
 // bigFtoa uses multiprecision computations to format a float.
 func bigFtoa(dst []byte, prec int, fmt byte, neg bool, mant uint64, exp int, flt *floatInfo) []byte {
 	d := new(decimal)
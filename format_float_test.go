@@ -246,6 +246,90 @@ func TestFtoaRandom(t *testing.T) {
 	}
 }
 
+func TestFtoaLargePrecisionRandom(t *testing.T) {
+	N := int(1e4)
+	if testing.Short() {
+		N = 100
+	}
+	t.Logf("testing %d random numbers with fast and slow fixed-precision FormatFloat", N)
+	for i := 0; i < N; i++ {
+		bits := uint64(rand.Uint32())<<32 | uint64(rand.Uint32())
+		x := math.Float64frombits(bits)
+		for _, fmt := range []byte{'e', 'f', 'g'} {
+			prec := rand.Intn(40) + 15
+			if i%16 == 0 {
+				prec = rand.Intn(1100)
+			}
+			fast := FormatFloat(x, fmt, prec, 64)
+			SetOptimize(false)
+			slow := FormatFloat(x, fmt, prec, 64)
+			SetOptimize(true)
+			if slow != fast {
+				t.Errorf("%b %%.%d%c printed as %s, want %s", x, prec, fmt, fast, slow)
+			}
+		}
+	}
+}
+
+func TestFtoaLargePrecisionRounding(t *testing.T) {
+	tests := []ftoaTest{
+		{0.5, 'f', 0, "0"},
+		{1.5, 'f', 0, "2"},
+		{2.5, 'f', 0, "2"},
+		{0.125, 'f', 2, "0.12"},
+		{0.375, 'f', 2, "0.38"},
+		{0.0005, 'f', 3, "0.001"},
+		{0.0004999, 'f', 3, "0.000"},
+		{999999.5, 'f', 0, "1000000"},
+		{1e23, 'e', 25, "9.9999999999999991611392000e+22"},
+		{1e300, 'f', 2, "1000000000000000052504760255204420248704468581108159154915854115511802457988908195786371375080447864043704443832883878176942523235360430575644792184786706982848387200926575803737830233794788090059368953234970799945081119038967640880074652742780142494579258788820056842838115669472196386865459400540160.00"},
+		{5e-324, 'e', 30, "4.940656458412465441765687928682e-324"},
+		{0.1, 'f', 60, "0.100000000000000005551115123125782702118158340454101562500000"},
+		{math.MaxFloat64, 'g', 40, "1.797693134862315708145274237317043567981e+308"},
+		{math.Ldexp(1, -1074), 'f', 3, "0.000"},
+	}
+	for _, test := range tests {
+		if s := FormatFloat(test.f, test.fmt, test.prec, 64); s != test.s {
+			t.Errorf("FormatFloat(%v, %c, %d) = %s, want %s", test.f, test.fmt, test.prec, s, test.s)
+		}
+	}
+}
+
+func TestSchubfachShortest(t *testing.T) {
+	N := int(1e5)
+	if testing.Short() {
+		N = 1000
+	}
+	var buf1, buf2 [32]byte
+	check := func(f float64) {
+		f = math.Abs(f)
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return
+		}
+		d1, dp1 := ShortestRyu(buf1[:], f)
+		d2, dp2 := ShortestSchubfach(buf2[:], f)
+		if string(d1) != string(d2) || dp1 != dp2 {
+			t.Errorf("%b: Schubfach gives 0.%se%d, Ryu gives 0.%se%d", f, d2, dp2, d1, dp1)
+		}
+	}
+	// Every binade, including the asymmetric intervals at powers of two.
+	for exp := uint64(0); exp < 1<<11-1; exp++ {
+		for _, mant := range []uint64{0, 1, 2, 3, 1<<52 - 2, 1<<52 - 1} {
+			check(math.Float64frombits(exp<<52 | mant))
+		}
+	}
+	for i := 0; i < N; i++ {
+		check(math.Float64frombits(uint64(rand.Uint32())<<32 | uint64(rand.Uint32())))
+		check(float64(rand.Int63()))
+		check(float64(rand.Intn(1e6)) / 1e3)
+		check(float64(rand.Int63n(1<<53)) * math.Pow(2, float64(rand.Intn(2000)-1000)))
+		check(math.Float64frombits(uint64(i)))
+	}
+	for _, f := range []float64{1, 0.1, 1e23, below1e23, above1e23, 5e-324, math.MaxFloat64, math.SmallestNonzeroFloat64, 1 << 53, 1<<53 + 2} {
+		check(f)
+	}
+}
+
 func TestFormatFloatInvalidBitSize(t *testing.T) {
 	defer func() {
 		if r := recover(); r == nil {
@@ -290,6 +374,10 @@ var ftoaBenches = []struct {
 	{"64Fixed12Hard", math.Ldexp(6965949469487146, -249), 'e', 12, 64},
 	{"64Fixed17Hard", math.Ldexp(8887055249355788, 665), 'e', 17, 64},
 	{"64Fixed18Hard", math.Ldexp(6994187472632449, 690), 'e', 18, 64},
+	{"64Fixed25", 6.02214076e23, 'e', 25, 64},
+	{"64Fixed40Denormal", 5e-324, 'e', 40, 64},
+	{"64FixedF6", 3.141592653589793, 'f', 6, 64},
+	{"64FixedFHuge", 1e300, 'f', 2, 64},
 
 	// Trigger slow path (see issue #15672).
 	// The shortest is: 8.034137530808823e+43
@@ -0,0 +1,143 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import "math/bits"
+
+// Schubfach shortest float64 to decimal conversion.
+//
+// Reference: "The Schubfach way to render doubles" by Raffaello Giulietti
+// https://drive.google.com/file/d/1gp5xv4CAa78SVgCeWfGqqI4FfYYYuNFb
+//
+// Unlike Ryū, which narrows the rounding interval digit by digit, Schubfach
+// scales the value and both interval bounds by a single power of ten with
+// round-to-odd 128-bit products and then chooses between at most four
+// candidates. The output is identical to ryuFtoaShortest.
+
+// schubfachFtoa64 formats mant*2^exp, a float64, with the shortest
+// decimal that reads back to the same value.
+func schubfachFtoa64(d *decimalSlice, mant uint64, exp int) {
+	if mant == 0 {
+		d.nd, d.dp = 0, 0
+		return
+	}
+	// Integers below 2^53 are their own shortest representation.
+	if exp <= 0 && bits.TrailingZeros64(mant) >= -exp {
+		schubfachDigits(d, mant>>uint(-exp), 0)
+		return
+	}
+
+	// The rounding interval of c*2^q is [cbl, cbr]*2^(q-2), open when c
+	// is odd. At a power of two the lower neighbour is twice as close.
+	c, q := mant, exp
+	out := c & 1
+	cb := c << 2
+	cbr := cb + 2
+	var cbl uint64
+	var k int
+	if c != 1<<float64info.mantbits || q == float64info.bias+1-int(float64info.mantbits) {
+		cbl = cb - 2
+		k = mulByLog2Log10(q)
+	} else {
+		cbl = cb - 1
+		k = mulByLog2Log10ThreeQuarters(q)
+	}
+
+	// Scale by 10^-k so that the interval has 1 or 2 integer digits left
+	// of the point. g is 10^-k normalized to 128 bits, rounded up.
+	h := q + mulByLog10Log2(-k) + 1
+	pow := &detailedPowersOfTen[-k-detailedPowersOfTenMinExp10]
+	gLo, carry := bits.Add64(pow[0], 1, 0)
+	gHi := pow[1] + carry
+	vbl := roundToOdd(gHi, gLo, cbl<<uint(h))
+	vb := roundToOdd(gHi, gLo, cb<<uint(h))
+	vbr := roundToOdd(gHi, gLo, cbr<<uint(h))
+
+	lower := vbl + out
+	upper := vbr - out
+	s := vb >> 2
+	if s >= 10 {
+		// Prefer one digit less if either neighbour is in the interval.
+		sp := s / 10
+		upIn := lower <= 40*sp
+		wpIn := 40*sp+40 <= upper
+		if upIn != wpIn {
+			if wpIn {
+				sp++
+			}
+			schubfachDigits(d, sp, k+1)
+			return
+		}
+	}
+	uIn := lower <= 4*s
+	wIn := 4*s+4 <= upper
+	if uIn != wIn {
+		if wIn {
+			s++
+		}
+		schubfachDigits(d, s, k)
+		return
+	}
+	// Both or neither candidates are in the interval: take the closest,
+	// rounding half to even.
+	mid := 4*s + 2
+	if vb > mid || vb == mid && s&1 != 0 {
+		s++
+	}
+	schubfachDigits(d, s, k)
+}
+
+// roundToOdd returns the integer part of (g*cp) >> 128, with its lowest
+// bit set if the discarded bits are not all zero.
+func roundToOdd(gHi, gLo, cp uint64) uint64 {
+	xHi, _ := bits.Mul64(cp, gLo)
+	yHi, yLo := bits.Mul64(cp, gHi)
+	yLo, carry := bits.Add64(yLo, xHi, 0)
+	yHi += carry
+	if yLo > 1 {
+		yHi |= 1
+	}
+	return yHi
+}
+
+// mulByLog2Log10ThreeQuarters returns math.Floor(x*log(2)/log(10) +
+// log(3/4)/log(10)) for an integer x in the range -1600 <= x && x <= +1600.
+func mulByLog2Log10ThreeQuarters(x int) int {
+	return (x*1262611 - 524031) >> 22
+}
+
+// schubfachDigits stores the decimal m*10^k in d without trailing zeros.
+func schubfachDigits(d *decimalSlice, m uint64, k int) {
+	// Most short decimals come out of the interval search padded to 16 or
+	// 17 digits, so strip the zeros before rendering.
+	for m%100 == 0 {
+		m /= 100
+		k += 2
+	}
+	if m%10 == 0 {
+		m /= 10
+		k++
+	}
+	// 1233/4096 approximates log10(2).
+	n := (bits.Len64(m) * 1233) >> 12
+	if m >= uint64pow10[n] {
+		n++
+	}
+	d.nd, d.dp = n, n+k
+	for m >= 100 {
+		q := m / 100
+		r := m - 100*q
+		n -= 2
+		d.d[n] = smallsString[2*r]
+		d.d[n+1] = smallsString[2*r+1]
+		m = q
+	}
+	if m >= 10 {
+		d.d[0] = smallsString[2*m]
+		d.d[1] = smallsString[2*m+1]
+	} else {
+		d.d[0] = byte('0' + m)
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !fastparse_ryu
+
+package fastparse
+
+// schubfachShortest selects Schubfach for shortest float64 formatting.
+// Build with -tags fastparse_ryu to use Ryū instead.
+const schubfachShortest = true
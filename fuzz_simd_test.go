@@ -26,6 +26,9 @@ func FuzzParseFloatSIMD(f *testing.F) {
 	for _, seed := range seeds {
 		f.Add(seed)
 	}
+	for _, c := range readFloatCorpus(f, "atof.txt") {
+		f.Add(c[1])
+	}
 
 	f.Fuzz(func(t *testing.T, s string) {
 		// Test float64
@@ -5,9 +5,12 @@
 package fastparse_test
 
 import (
+	"encoding/json"
 	"math"
 	"strconv"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/mshafiee/fastparse"
 )
@@ -23,6 +26,15 @@ func FuzzFormatFloat(f *testing.F) {
 	f.Add(math.NaN(), byte('g'), int(-1), int(64))
 	f.Add(math.Inf(1), byte('g'), int(-1), int(64))
 	f.Add(math.Inf(-1), byte('g'), int(-1), int(64))
+	for _, c := range fastparse.ReadFloatCorpus(f, "ftoa.txt") {
+		bitSize := 64
+		if c[0] == "float32" {
+			bitSize = 32
+		}
+		v, _ := strconv.ParseFloat(c[1], bitSize)
+		prec, _ := strconv.Atoi(c[3])
+		f.Add(v, c[2][0], prec, bitSize)
+	}
 
 	f.Fuzz(func(t *testing.T, val float64, fmt byte, prec int, bitSize int) {
 		// Limit inputs to valid ranges
@@ -103,6 +115,51 @@ func FuzzUnquote(f *testing.F) {
 	})
 }
 
+func FuzzQuoteJSON(f *testing.F) {
+	// Seed corpus
+	f.Add("Hello, World!", uint8(0))
+	f.Add("<a href=\"x\">&amp;</a>", uint8(fastparse.JSONEscapeHTML))
+	f.Add("Unicode: \u4e16\u754c \U0001F680 \u2028", uint8(fastparse.JSONASCIIOnly))
+	f.Add("Invalid: \xff\xed\xa0\x80", uint8(0))
+	f.Add("\x00\x1f\x7f", uint8(0))
+
+	f.Fuzz(func(t *testing.T, s string, flags uint8) {
+		flags &= uint8(fastparse.JSONEscapeHTML | fastparse.JSONASCIIOnly)
+		q := fastparse.QuoteJSON(s, fastparse.JSONFlags(flags))
+		if flags&uint8(fastparse.JSONASCIIOnly) == 0 {
+			want := jsonEncode(t, s, flags&uint8(fastparse.JSONEscapeHTML) != 0)
+			if q != want {
+				t.Errorf("QuoteJSON mismatch:\nInput: %q\nFast: %s\nJSON: %s", s, q, want)
+			}
+		}
+		u, err := fastparse.UnquoteJSON(q)
+		if err != nil || utf8.ValidString(s) && u != s {
+			t.Errorf("UnquoteJSON(QuoteJSON(%q)) = %q, %v", s, u, err)
+		}
+	})
+}
+
+func FuzzUnquoteJSON(f *testing.F) {
+	// Seed corpus
+	f.Add(`"Hello, World!"`)
+	f.Add(`"Line1\nLine2\/"`)
+	f.Add(`"\u4e16\ud83d\ude80\ud800"`)
+	f.Add(`"\'"`)
+	f.Add("\"\xff\"")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if strings.Trim(s, " \t\r\n") != s {
+			return // json.Unmarshal allows surrounding whitespace
+		}
+		var want string
+		wantErr := json.Unmarshal([]byte(s), &want)
+		got, err := fastparse.UnquoteJSON(s)
+		if (err == nil) != (wantErr == nil) || got != want {
+			t.Errorf("UnquoteJSON mismatch:\nInput: %#q\nFast: %q, %v\nJSON: %q, %v", s, got, err, want, wantErr)
+		}
+	})
+}
+
 func FuzzParseComplex(f *testing.F) {
 	// Seed corpus
 	f.Add("(1+2i)", int(128))
@@ -111,6 +168,10 @@ func FuzzParseComplex(f *testing.F) {
 	f.Add("5", int(128))
 	f.Add("3i", int(128))
 	f.Add("(-1-1i)", int(64))
+	for _, c := range fastparse.ReadFloatCorpus(f, "complex.txt") {
+		bitSize, _ := strconv.Atoi(c[0])
+		f.Add(c[1], bitSize)
+	}
 
 	f.Fuzz(func(t *testing.T, s string, bitSize int) {
 		if bitSize != 64 && bitSize != 128 {
@@ -6,8 +6,13 @@
 
 package classifier
 
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
 // Classify uses the AMD64 assembly-optimized classifier.
 func Classify(s string) Pattern {
+	if !dispatch.Allow(dispatch.SSE) {
+		return ClassifyPureGo(s)
+	}
 	return classifyAmd64(s)
 }
 
@@ -6,8 +6,13 @@
 
 package classifier
 
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
 // Classify uses the ARM64 assembly-optimized classifier.
 func Classify(s string) Pattern {
+	if !dispatch.Allow(dispatch.NEON) {
+		return ClassifyPureGo(s)
+	}
 	return classifyArm64(s)
 }
 
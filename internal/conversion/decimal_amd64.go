@@ -6,7 +6,7 @@
 
 package conversion
 
-import "golang.org/x/sys/cpu"
+import "github.com/mshafiee/fastparse/internal/dispatch"
 
 // Assembly implementations
 //
@@ -16,22 +16,21 @@ func convertDecimalExactAsm(mantissa uint64, exp int, neg bool, pow10Table []flo
 //go:noescape
 func convertDecimalExtendedAsm(mantissa uint64, exp int, neg bool, pow10Table []float64) (float64, bool)
 
-var useFMA bool
-
-func init() {
-	// Check for FMA3 support
-	useFMA = cpu.X86.HasFMA
+// useFMA reports whether the FMA3 kernels may run. FMA3 arrived with
+// AVX2, and the kernels run at that level.
+func useFMA() bool {
+	return dispatch.HasFMA && dispatch.Allow(dispatch.AVX2)
 }
 
 func convertDecimalExactImpl(mantissa uint64, exp int, neg bool, pow10Table []float64) (float64, bool) {
-	if useFMA {
+	if useFMA() {
 		return convertDecimalExactAsm(mantissa, exp, neg, pow10Table)
 	}
 	return convertDecimalExactScalar(mantissa, exp, neg, pow10Table)
 }
 
 func convertDecimalExtendedImpl(mantissa uint64, exp int, neg bool, pow10Table []float64) (float64, bool) {
-	if useFMA {
+	if useFMA() {
 		return convertDecimalExtendedAsm(mantissa, exp, neg, pow10Table)
 	}
 	return convertDecimalExtendedScalar(mantissa, exp, neg, pow10Table)
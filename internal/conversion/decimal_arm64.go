@@ -6,6 +6,8 @@
 
 package conversion
 
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
 // Assembly implementations
 //
 //go:noescape
@@ -15,9 +17,15 @@ func convertDecimalExactAsm(mantissa uint64, exp int, neg bool, pow10Table []flo
 func convertDecimalExtendedAsm(mantissa uint64, exp int, neg bool, pow10Table []float64) (float64, bool)
 
 func convertDecimalExactImpl(mantissa uint64, exp int, neg bool, pow10Table []float64) (float64, bool) {
+	if !dispatch.Allow(dispatch.NEON) {
+		return convertDecimalExactScalar(mantissa, exp, neg, pow10Table)
+	}
 	return convertDecimalExactAsm(mantissa, exp, neg, pow10Table)
 }
 
 func convertDecimalExtendedImpl(mantissa uint64, exp int, neg bool, pow10Table []float64) (float64, bool) {
+	if !dispatch.Allow(dispatch.NEON) {
+		return convertDecimalExtendedScalar(mantissa, exp, neg, pow10Table)
+	}
 	return convertDecimalExtendedAsm(mantissa, exp, neg, pow10Table)
 }
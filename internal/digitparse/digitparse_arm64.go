@@ -6,6 +6,8 @@
 
 package digitparse
 
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
 // parseDigitsToUint64Asm is the assembly implementation
 //
 //go:noescape
@@ -17,6 +19,9 @@ func parseDigitsToUint64Asm(s string, offset int) (mantissa uint64, digitCount i
 func parseDigitsWithDotAsm(s string, offset int) (mantissa uint64, digitsBeforeDot int, totalDigits int, foundDot bool, ok bool)
 
 func parseDigitsToUint64Impl(s string, offset int) (uint64, int, bool) {
+	if !dispatch.Allow(dispatch.NEON) {
+		return parseDigitsToUint64Scalar(s, offset)
+	}
 	if offset >= len(s) {
 		return 0, 0, false
 	}
@@ -24,6 +29,9 @@ func parseDigitsToUint64Impl(s string, offset int) (uint64, int, bool) {
 }
 
 func parseDigitsWithDotImpl(s string, offset int) (uint64, int, int, bool, bool) {
+	if !dispatch.Allow(dispatch.NEON) {
+		return parseDigitsWithDotScalar(s, offset)
+	}
 	if offset >= len(s) {
 		return 0, 0, 0, false, false
 	}
@@ -0,0 +1,178 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package dispatch holds the CPU features detected at start-up and the
+// implementation level in force. Every assembly kernel asks Allow before
+// it runs, so lowering the level with Set or the FASTPARSE_DISABLE
+// environment variable moves all of them to portable code at once.
+package dispatch
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/sys/cpu"
+)
+
+// A Level is a family of kernels. On amd64 the levels are ordered, each
+// including the ones below it; on arm64 there is only NEON above Generic.
+type Level uint32
+
+const (
+	Generic Level = iota // portable Go
+	SSE                  // the baseline amd64 assembly, needing at most SSE2
+	AVX2                 // AVX2 kernels, with BMI2 and FMA where used
+	AVX512               // AVX-512 kernels, needing AVX-512F and AVX-512BW
+	NEON                 // the arm64 assembly
+)
+
+var levelNames = [...]string{
+	Generic: "Generic",
+	SSE:     "SSE",
+	AVX2:    "AVX2",
+	AVX512:  "AVX512",
+	NEON:    "NEON",
+}
+
+func (l Level) String() string {
+	if int(l) < len(levelNames) {
+		return levelNames[l]
+	}
+	return "Level(" + strconv.Itoa(int(l)) + ")"
+}
+
+// CPU features, as detected at start-up.
+var (
+	HasSSE2     = cpu.X86.HasSSE2
+	HasAVX2     = cpu.X86.HasAVX2
+	HasAVX512F  = cpu.X86.HasAVX512F
+	HasAVX512BW = cpu.X86.HasAVX512BW
+	HasBMI2     = cpu.X86.HasBMI2
+	HasFMA      = cpu.X86.HasFMA
+	HasNEON     = runtime.GOARCH == "arm64" && cpu.ARM64.HasASIMD
+)
+
+// max is the best level the CPU supports.
+var max = detect()
+
+// level is the level in force.
+var level atomic.Uint32
+
+func init() {
+	level.Store(uint32(min(max, Disable(os.Getenv("FASTPARSE_DISABLE")))))
+}
+
+func detect() Level {
+	switch {
+	case runtime.GOARCH == "arm64":
+		if HasNEON {
+			return NEON
+		}
+	case runtime.GOARCH == "amd64":
+		switch {
+		case HasAVX512F && HasAVX512BW && HasAVX2:
+			return AVX512
+		case HasAVX2:
+			return AVX2
+		}
+		return SSE
+	}
+	return Generic
+}
+
+// Max returns the best level the CPU supports.
+func Max() Level { return max }
+
+// Current returns the level in force.
+func Current() Level { return Level(level.Load()) }
+
+// Allow reports whether kernels of level l may run. Kernels only ask for
+// the levels of their own architecture.
+func Allow(l Level) bool { return l <= Level(level.Load()) }
+
+// Supported reports whether the CPU can run kernels of level l.
+func Supported(l Level) bool {
+	if l == Generic {
+		return true
+	}
+	if l == NEON {
+		return max == NEON
+	}
+	return max != NEON && l <= max
+}
+
+// Set puts level l in force, unless the CPU does not support it, in which
+// case it reports false and changes nothing.
+func Set(l Level) bool {
+	if !Supported(l) {
+		return false
+	}
+	level.Store(uint32(l))
+	return true
+}
+
+// ParseLevels returns the levels named in list, a comma-separated list
+// such as "Generic,AVX2" matched without regard to case, or every level
+// the CPU supports if list is empty. It fails on an unknown name or a
+// level the CPU cannot run. The levels are converted to L, such as
+// fastparse.Implementation, whose values are those of Level.
+func ParseLevels[L ~uint8 | ~uint32](list string) ([]L, error) {
+	var levels []L
+	if list == "" {
+		for l := range Level(len(levelNames)) {
+			if Supported(l) {
+				levels = append(levels, L(l))
+			}
+		}
+		return levels, nil
+	}
+	for _, name := range strings.Split(list, ",") {
+		found := false
+		for l := range Level(len(levelNames)) {
+			if strings.EqualFold(name, l.String()) {
+				if !Supported(l) {
+					return nil, fmt.Errorf("%v: not supported by this CPU", l)
+				}
+				levels = append(levels, L(l))
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown implementation %q", name)
+		}
+	}
+	return levels, nil
+}
+
+// Disable returns the highest level allowed by spec, a comma- or
+// space-separated list of the features to turn off, in the syntax of
+// FASTPARSE_DISABLE: avx512, avx2, sse, neon, or simd or all for every
+// kernel. Turning a feature off also turns off the levels built on it.
+// Names of another architecture's features and unknown names have no
+// effect.
+func Disable(spec string) Level {
+	x86, arm := AVX512, NEON
+	for _, f := range strings.FieldsFunc(strings.ToLower(spec), func(r rune) bool { return r == ',' || r == ' ' }) {
+		switch f {
+		case "avx512":
+			x86 = min(x86, AVX2)
+		case "avx2":
+			x86 = min(x86, SSE)
+		case "sse":
+			x86 = Generic
+		case "neon":
+			arm = Generic
+		case "simd", "all":
+			x86, arm = Generic, Generic
+		}
+	}
+	if runtime.GOARCH == "arm64" {
+		return arm
+	}
+	return x86
+}
@@ -9,6 +9,8 @@ package eisel_lemire
 import (
 	"math"
 	"runtime"
+
+	"github.com/mshafiee/fastparse/internal/dispatch"
 )
 
 // tryParseAsm is the assembly implementation of Eisel-Lemire algorithm
@@ -21,8 +23,9 @@ func tryParseAsm(mantissa uint64, exp10 int, tablePtrHigh *uint64, tablePtrLow *
 func TryParse(mantissa uint64, exp10 int) (float64, bool) {
 	// The amd64 assembly path is currently disabled due to rounding issues
 	// observed when running under emulation. Fall back to the reference Go
-	// implementation for amd64 until the assembly is fixed.
-	if runtime.GOARCH != "arm64" {
+	// implementation for amd64 until the assembly is fixed, and on arm64
+	// when the NEON level is turned off.
+	if runtime.GOARCH != "arm64" || !dispatch.Allow(dispatch.NEON) {
 		return tryParseFallback(mantissa, exp10)
 	}
 
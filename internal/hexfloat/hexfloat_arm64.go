@@ -6,12 +6,17 @@
 
 package hexfloat
 
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
 // parseHexMantissaAsm is the assembly implementation
 //
 //go:noescape
 func parseHexMantissaAsm(s string, offset int, maxDigits int) (mantissa uint64, hexIntDigits int, hexFracDigits int, digitsParsed int, ok bool)
 
 func parseHexMantissaImpl(s string, offset int, maxDigits int) (uint64, int, int, int, bool) {
+	if !dispatch.Allow(dispatch.NEON) {
+		return parseHexMantissaScalar(s, offset, maxDigits)
+	}
 	if offset >= len(s) || maxDigits <= 0 {
 		return 0, 0, 0, 0, false
 	}
@@ -0,0 +1,77 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quoting
+
+// A ByteClass is a set of bytes that can be searched for with SIMD.
+//
+// The vector scan uses the nibble-lookup technique: each byte is split
+// into its low and high nibble, both are looked up in a 16-entry table,
+// and the byte is in the class if the two lookups share a bit. This can
+// represent any set whose 16 rows (bytes sharing a high nibble) use at
+// most 8 distinct patterns of low nibbles.
+type ByteClass struct {
+	nibbles [32]byte // low-nibble table, then high-nibble table
+	member  [256]bool
+}
+
+// NewByteClass returns the class of bytes for which in returns true.
+// It panics if the class needs more than 8 distinct row patterns.
+func NewByteClass(in func(b byte) bool) *ByteClass {
+	c := new(ByteClass)
+	var rows [16]uint16 // low nibbles in the class, per high nibble
+	for i := 0; i < 256; i++ {
+		if in(byte(i)) {
+			c.member[i] = true
+			rows[i>>4] |= 1 << (i & 15)
+		}
+	}
+	var patterns []uint16
+	for hi, row := range rows {
+		if row == 0 {
+			continue
+		}
+		bit := -1
+		for j, p := range patterns {
+			if p == row {
+				bit = j
+			}
+		}
+		if bit < 0 {
+			bit = len(patterns)
+			if bit == 8 {
+				panic("quoting: byte class has too many row patterns")
+			}
+			patterns = append(patterns, row)
+			for lo := 0; lo < 16; lo++ {
+				if row&(1<<lo) != 0 {
+					c.nibbles[lo] |= 1 << bit
+				}
+			}
+		}
+		c.nibbles[16+hi] = 1 << bit
+	}
+	return c
+}
+
+// Contains reports whether b is in the class.
+func (c *ByteClass) Contains(b byte) bool {
+	return c.member[b]
+}
+
+// Index returns the index of the first byte of s in the class, or -1.
+func (c *ByteClass) Index(s string) int {
+	if len(s) >= 32 && useClassASM() {
+		if i := indexClassASM(s, &c.nibbles); i < len(s) {
+			return i
+		}
+		return -1
+	}
+	for i := 0; i < len(s); i++ {
+		if c.member[s[i]] {
+			return i
+		}
+	}
+	return -1
+}
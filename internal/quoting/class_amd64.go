@@ -0,0 +1,19 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64
+
+package quoting
+
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
+// useClassASM reports whether indexClassASM may run.
+func useClassASM() bool { return dispatch.Allow(dispatch.AVX2) }
+
+// indexClassASM scans s 32 bytes at a time with AVX2 and returns the index
+// of the first byte in the class described by nibbles, or len(s) if there
+// is none. It requires len(s) >= 32.
+//
+//go:noescape
+func indexClassASM(s string, nibbles *[32]byte) int
@@ -0,0 +1,75 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64
+
+#include "textflag.h"
+
+// func indexClassASM(s string, nibbles *[32]byte) int
+// AVX2 nibble-lookup scan, 32 bytes at a time; requires len(s) >= 32
+TEXT ·indexClassASM(SB), NOSPLIT, $0-32
+	MOVQ s_base+0(FP), SI    // SI = string data pointer
+	MOVQ s_len+8(FP), CX     // CX = string length
+	MOVQ nibbles+16(FP), AX
+
+	// Y0 = low-nibble table, Y1 = high-nibble table, in both lanes
+	VBROADCASTI128 (AX), Y0
+	VBROADCASTI128 16(AX), Y1
+
+	// Y2 = 0x0F repeated 32 times
+	MOVQ $0x0F, AX
+	VMOVQ AX, X2
+	VPBROADCASTB X2, Y2
+
+	VPXOR Y5, Y5, Y5         // Y5 = zero
+	XORQ DX, DX              // DX = index
+
+loop:
+	LEAQ 32(DX), BX
+	CMPQ BX, CX
+	JHI tail                 // fewer than 32 bytes left
+
+	VMOVDQU (SI)(DX*1), Y3
+	VPSRLW $4, Y3, Y4
+	VPAND Y2, Y3, Y3         // Y3 = low nibbles
+	VPAND Y2, Y4, Y4         // Y4 = high nibbles
+	VPSHUFB Y3, Y0, Y3       // Y3 = lo table[low nibble]
+	VPSHUFB Y4, Y1, Y4       // Y4 = hi table[high nibble]
+	VPAND Y3, Y4, Y3
+	VPCMPEQB Y5, Y3, Y3      // 0xFF where the byte is not in the class
+	VPMOVMSKB Y3, AX
+	XORL $0xFFFFFFFF, AX     // bits set where the byte is in the class
+	JNZ found
+
+	MOVQ BX, DX
+	JMP loop
+
+tail:
+	// Scan the last 32 bytes, overlapping bytes already known to be
+	// outside the class, so any match is past DX.
+	CMPQ DX, CX
+	JEQ done
+	LEAQ -32(CX), DX
+	VMOVDQU (SI)(DX*1), Y3
+	VPSRLW $4, Y3, Y4
+	VPAND Y2, Y3, Y3
+	VPAND Y2, Y4, Y4
+	VPSHUFB Y3, Y0, Y3
+	VPSHUFB Y4, Y1, Y4
+	VPAND Y3, Y4, Y3
+	VPCMPEQB Y5, Y3, Y3
+	VPMOVMSKB Y3, AX
+	XORL $0xFFFFFFFF, AX
+	JNZ found
+	MOVQ CX, DX
+	JMP done
+
+found:
+	BSFL AX, AX
+	ADDQ AX, DX
+
+done:
+	VZEROUPPER
+	MOVQ DX, ret+24(FP)
+	RET
@@ -0,0 +1,15 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64
+
+package quoting
+
+// useClassASM reports whether indexClassASM may run.
+func useClassASM() bool { return false }
+
+// indexClassASM is not available on this platform
+func indexClassASM(s string, nibbles *[32]byte) int {
+	panic("unreachable")
+}
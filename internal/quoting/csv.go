@@ -0,0 +1,49 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quoting
+
+import (
+	"strings"
+	"sync/atomic"
+)
+
+// RFC 4180 CSV fields.
+
+// csvSpecial caches the CSVSpecial class of each separator.
+var csvSpecial [128]atomic.Pointer[ByteClass]
+
+// CSVSpecial returns the class of bytes that force a CSV field separated
+// by sep to be quoted: sep, the double quote, CR and LF. sep must be ASCII.
+func CSVSpecial(sep byte) *ByteClass {
+	if c := csvSpecial[sep].Load(); c != nil {
+		return c
+	}
+	c := NewByteClass(func(b byte) bool {
+		return b == sep || b == '"' || b == '\r' || b == '\n'
+	})
+	csvSpecial[sep].Store(c)
+	return c
+}
+
+// AppendCSV appends s to dst as a CSV field separated by sep. The field is
+// quoted only if it contains a byte of CSVSpecial(sep), and each quote in
+// a quoted field is doubled.
+func AppendCSV(dst []byte, s string, sep byte) []byte {
+	if CSVSpecial(sep).Index(s) < 0 {
+		return append(dst, s...)
+	}
+	dst = append(dst, '"')
+	for {
+		i := strings.IndexByte(s, '"')
+		if i < 0 {
+			break
+		}
+		dst = append(dst, s[:i+1]...)
+		dst = append(dst, '"')
+		s = s[i+1:]
+	}
+	dst = append(dst, s...)
+	return append(dst, '"')
+}
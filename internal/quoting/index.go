@@ -0,0 +1,63 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quoting
+
+import "math/bits"
+
+// IndexEscape returns the index of the first byte of s that may need
+// escaping in a Go literal quoted with quote, or -1 if there is none.
+// Such bytes are the quote, a backslash, the ASCII control characters,
+// DEL and every non-ASCII byte; the bytes before the index can be copied
+// to the literal unchanged in any quoting mode.
+func IndexEscape(s string, quote byte) int {
+	if len(s) >= 8 && useIndexASM() {
+		return indexEscapeASM(s, quote)
+	}
+	return indexEscapeGeneric(s, quote)
+}
+
+// UnquoteSpecial holds the bytes that end a run of literal characters in
+// a double-quoted Go string literal: the quote, backslash and LF.
+var UnquoteSpecial = NewByteClass(func(b byte) bool {
+	return b == '"' || b == '\\' || b == '\n'
+})
+
+const (
+	lsb = 0x0101010101010101
+	msb = 0x8080808080808080
+)
+
+// indexEscapeGeneric is IndexEscape eight bytes at a time in a uint64.
+// quote must be ASCII.
+func indexEscapeGeneric(s string, quote byte) int {
+	i := 0
+	for ; i+8 <= len(s); i += 8 {
+		w := uint64(s[i]) | uint64(s[i+1])<<8 | uint64(s[i+2])<<16 | uint64(s[i+3])<<24 |
+			uint64(s[i+4])<<32 | uint64(s[i+5])<<40 | uint64(s[i+6])<<48 | uint64(s[i+7])<<56
+		if m := escapeMask(w, quote); m != 0 {
+			return i + bits.TrailingZeros64(m)/8
+		}
+	}
+	for ; i < len(s); i++ {
+		if c := s[i]; c < ' ' || c >= 0x7F || c == quote || c == '\\' {
+			return i
+		}
+	}
+	return -1
+}
+
+// escapeMask returns a word with the high bit set in each byte of w that
+// IndexEscape stops at. No carries cross byte boundaries, so every flagged
+// byte is exact.
+func escapeMask(w uint64, quote byte) uint64 {
+	low := w &^ msb
+	// Bit 7 of low+0x60 is set for bytes >= 0x20.
+	ctl := ^(low + 0x60*lsb)
+	// A byte of low^c+0x7F has bit 7 clear only if it equals c.
+	q := ^((low ^ uint64(quote)*lsb) + 0x7F*lsb)
+	bs := ^((low ^ '\\'*lsb) + 0x7F*lsb)
+	del := ^((low ^ 0x7F*lsb) + 0x7F*lsb)
+	return (w | ctl | q | bs | del) & msb
+}
@@ -0,0 +1,18 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64
+
+package quoting
+
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
+// useIndexASM reports whether indexEscapeASM may run.
+func useIndexASM() bool { return dispatch.Allow(dispatch.AVX2) }
+
+// indexEscapeASM returns IndexEscape(s, quote) using AVX2. It requires
+// len(s) >= 8.
+//
+//go:noescape
+func indexEscapeASM(s string, quote byte) int
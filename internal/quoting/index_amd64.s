@@ -0,0 +1,122 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64
+
+#include "textflag.h"
+
+// func indexEscapeASM(s string, quote byte) int
+// AVX2 scan for the first byte that may need escaping: the quote, a
+// backslash, a control character, DEL or any non-ASCII byte. len(s) >= 8.
+// Tails are covered by a final load that overlaps bytes already scanned.
+TEXT ·indexEscapeASM(SB), NOSPLIT, $0-32
+	MOVQ s_base+0(FP), SI    // SI = string data pointer
+	MOVQ s_len+8(FP), CX     // CX = string length
+	MOVBLZX quote+16(FP), AX
+
+	// Y0 = quote, Y1 = backslash, Y2 = space, Y3 = DEL, in every byte
+	VMOVD AX, X0
+	VPBROADCASTB X0, Y0
+	MOVL $0x5C, AX
+	VMOVD AX, X1
+	VPBROADCASTB X1, Y1
+	MOVL $0x20, AX
+	VMOVD AX, X2
+	VPBROADCASTB X2, Y2
+	MOVL $0x7F, AX
+	VMOVD AX, X3
+	VPBROADCASTB X3, Y3
+
+	XORQ DX, DX              // DX = index
+	CMPQ CX, $32
+	JB short
+
+loop:
+	VMOVDQU (SI)(DX*1), Y4
+	VPCMPEQB Y0, Y4, Y5
+	VPCMPEQB Y1, Y4, Y6
+	VPOR Y6, Y5, Y5
+	VPCMPGTB Y4, Y2, Y6      // signed byte < 0x20: controls and non-ASCII
+	VPOR Y6, Y5, Y5
+	VPCMPEQB Y3, Y4, Y6
+	VPOR Y6, Y5, Y5
+	VPMOVMSKB Y5, AX
+	TESTL AX, AX
+	JNZ found
+	ADDQ $32, DX
+	LEAQ 32(DX), BX
+	CMPQ BX, CX
+	JLS loop
+
+	// Rescan the last 32 bytes; those before DX are known to be clean.
+	CMPQ DX, CX
+	JEQ none
+	MOVQ CX, DX
+	SUBQ $32, DX
+	VMOVDQU (SI)(DX*1), Y4
+	VPCMPEQB Y0, Y4, Y5
+	VPCMPEQB Y1, Y4, Y6
+	VPOR Y6, Y5, Y5
+	VPCMPGTB Y4, Y2, Y6
+	VPOR Y6, Y5, Y5
+	VPCMPEQB Y3, Y4, Y6
+	VPOR Y6, Y5, Y5
+	VPMOVMSKB Y5, AX
+	TESTL AX, AX
+	JNZ found
+	JMP none
+
+short:
+	// 8 to 31 bytes: two possibly overlapping loads of 16 or 8 bytes.
+	CMPQ CX, $16
+	JB short8
+	VMOVDQU (SI), X4
+	VMOVDQU -16(SI)(CX*1), X7
+	MOVQ $0xFFFF, R8
+	MOVQ CX, R9
+	SUBQ $16, R9             // R9 = offset of the second load
+	JMP short_check
+
+short8:
+	VMOVQ (SI), X4
+	VMOVQ -8(SI)(CX*1), X7
+	MOVQ $0xFF, R8
+	MOVQ CX, R9
+	SUBQ $8, R9
+
+short_check:
+	VPCMPEQB X0, X4, X5
+	VPCMPEQB X1, X4, X6
+	VPOR X6, X5, X5
+	VPCMPGTB X4, X2, X6
+	VPOR X6, X5, X5
+	VPCMPEQB X3, X4, X6
+	VPOR X6, X5, X5
+	VPMOVMSKB X5, AX
+	ANDQ R8, AX              // VMOVQ zeroes the bytes past the first 8
+	JNZ found
+
+	MOVQ R9, DX
+	VPCMPEQB X0, X7, X5
+	VPCMPEQB X1, X7, X6
+	VPOR X6, X5, X5
+	VPCMPGTB X7, X2, X6
+	VPOR X6, X5, X5
+	VPCMPEQB X3, X7, X6
+	VPOR X6, X5, X5
+	VPMOVMSKB X5, AX
+	ANDQ R8, AX
+	JNZ found
+
+none:
+	VZEROUPPER
+	MOVQ $-1, ret+24(FP)
+	RET
+
+found:
+	BSFL AX, AX
+	ADDQ AX, DX
+	VZEROUPPER
+	MOVQ DX, ret+24(FP)
+	RET
@@ -0,0 +1,15 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64
+
+package quoting
+
+// useIndexASM reports whether indexEscapeASM may run.
+func useIndexASM() bool { return false }
+
+// indexEscapeASM is not available on this platform
+func indexEscapeASM(s string, quote byte) int {
+	panic("unreachable")
+}
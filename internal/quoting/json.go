@@ -0,0 +1,207 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quoting
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// JSON string literals, byte-for-byte compatible with encoding/json.
+
+// jsonSafe and jsonHTMLSafe report which ASCII bytes appear unescaped in a
+// JSON string, without and with HTML escaping respectively.
+var jsonSafe, jsonHTMLSafe = func() (safe, html [utf8.RuneSelf]bool) {
+	for b := ' '; b < utf8.RuneSelf; b++ {
+		safe[b] = b != '"' && b != '\\'
+		html[b] = safe[b] && b != '<' && b != '>' && b != '&'
+	}
+	return
+}()
+
+// AppendJSON appends s to dst as a double-quoted JSON string.
+//
+// With html set, <, > and & are escaped as \u003c, \u003e and \u0026. With
+// ascii set, all non-ASCII characters are escaped as \uXXXX, using a UTF-16
+// surrogate pair above U+FFFF. Like encoding/json, each invalid UTF-8 byte
+// is replaced by U+FFFD and U+2028 and U+2029 are always escaped.
+func AppendJSON(dst []byte, s string, html, ascii bool) []byte {
+	dst = append(dst, '"')
+	// Fast path: printable ASCII without quotes or backslashes is copied
+	// as is. The kernels do not know about HTML characters.
+	if !html && !checkNeedsEscaping(s, '"', ModeASCII) {
+		dst = append(dst, s...)
+		return append(dst, '"')
+	}
+
+	safe := &jsonSafe
+	if html {
+		safe = &jsonHTMLSafe
+	}
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if safe[b] {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			dst = appendJSONByte(dst, b)
+			i++
+			start = i
+			continue
+		}
+		r, width := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && width == 1 && !ascii:
+			dst = append(dst, s[start:i]...)
+			dst = utf8.AppendRune(dst, utf8.RuneError)
+		case r == '\u2028' || r == '\u2029' || ascii && r <= 0xFFFF:
+			dst = append(dst, s[start:i]...)
+			dst = appendJSONU4(dst, r)
+		case ascii:
+			dst = append(dst, s[start:i]...)
+			r1, r2 := utf16.EncodeRune(r)
+			dst = appendJSONU4(dst, r1)
+			dst = appendJSONU4(dst, r2)
+		default:
+			i += width
+			continue
+		}
+		i += width
+		start = i
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
+
+// jsonShort holds the letter of the short escape of each ASCII byte that
+// has one in JSON, or 0.
+var jsonShort = [utf8.RuneSelf]byte{
+	'"': '"', '\\': '\\', '\b': 'b', '\f': 'f', '\n': 'n', '\r': 'r', '\t': 't',
+}
+
+// appendJSONByte appends the escape of an ASCII byte that is not safe.
+func appendJSONByte(dst []byte, b byte) []byte {
+	if c := jsonShort[b]; c != 0 {
+		return append(dst, '\\', c)
+	}
+	return appendJSONU4(dst, rune(b))
+}
+
+// appendJSONU4 appends the escape \uXXXX for r <= 0xFFFF.
+func appendJSONU4(dst []byte, r rune) []byte {
+	return append(dst, '\\', 'u',
+		hexDigit(byte(r>>12&0xF)), hexDigit(byte(r>>8&0xF)),
+		hexDigit(byte(r>>4&0xF)), hexDigit(byte(r&0xF)))
+}
+
+// AppendUnquoteJSON appends the value of the JSON string literal s to dst.
+//
+// It accepts what encoding/json accepts: the escapes \" \\ \/ \b \f \n \r
+// \t and \uXXXX, and no unescaped quotes or control characters. Invalid
+// UTF-8 and unpaired surrogates are replaced by U+FFFD. If s is not a
+// valid JSON string, ok is false and dst is returned unchanged.
+func AppendUnquoteJSON(dst []byte, s string) (_ []byte, ok bool) {
+	n := len(dst)
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return dst, false
+	}
+	s = s[1 : len(s)-1]
+	// Fast path: printable ASCII without escapes is its own value.
+	if !checkNeedsEscaping(s, '"', ModeASCII) {
+		return append(dst, s...), true
+	}
+
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\':
+			dst = append(dst, s[start:i]...)
+			if i+1 >= len(s) {
+				return dst[:n], false
+			}
+			switch s[i+1] {
+			case '"', '\\', '/':
+				dst = append(dst, s[i+1])
+			case 'b':
+				dst = append(dst, '\b')
+			case 'f':
+				dst = append(dst, '\f')
+			case 'n':
+				dst = append(dst, '\n')
+			case 'r':
+				dst = append(dst, '\r')
+			case 't':
+				dst = append(dst, '\t')
+			case 'u':
+				r := getJSONU4(s[i:])
+				if r < 0 {
+					return dst[:n], false
+				}
+				i += 6
+				if utf16.IsSurrogate(r) {
+					// A valid pair is consumed whole; anything else leaves
+					// the next escape for the following iteration.
+					if r2 := getJSONU4(s[i:]); r2 >= 0 {
+						if dec := utf16.DecodeRune(r, r2); dec != utf8.RuneError {
+							r = dec
+							i += 6
+						} else {
+							r = utf8.RuneError
+						}
+					} else {
+						r = utf8.RuneError
+					}
+				}
+				dst = utf8.AppendRune(dst, r)
+				start = i
+				continue
+			default:
+				return dst[:n], false
+			}
+			i += 2
+			start = i
+		case c == '"' || c < ' ':
+			return dst[:n], false
+		case c < utf8.RuneSelf:
+			i++
+		default:
+			r, width := utf8.DecodeRuneInString(s[i:])
+			if r == utf8.RuneError && width == 1 {
+				dst = append(dst, s[start:i]...)
+				dst = utf8.AppendRune(dst, utf8.RuneError)
+				start = i + 1
+			}
+			i += width
+		}
+	}
+	return append(dst, s[start:]...), true
+}
+
+// getJSONU4 decodes the escape \uXXXX at the start of s, returning -1 if
+// there is none.
+func getJSONU4(s string) rune {
+	if len(s) < 6 || s[0] != '\\' || s[1] != 'u' {
+		return -1
+	}
+	var r rune
+	for i := 2; i < 6; i++ {
+		c := s[i]
+		switch {
+		case '0' <= c && c <= '9':
+			c -= '0'
+		case 'a' <= c && c <= 'f':
+			c -= 'a' - 10
+		case 'A' <= c && c <= 'F':
+			c -= 'A' - 10
+		default:
+			return -1
+		}
+		r = r<<4 | rune(c)
+	}
+	return r
+}
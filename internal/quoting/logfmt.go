@@ -0,0 +1,70 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quoting
+
+import "unicode/utf8"
+
+// Logfmt keys and values.
+
+// LogfmtSpecial holds the bytes that force a logfmt value to be quoted,
+// and that a key must not contain: space, the ASCII control characters,
+// '=' and the double quote.
+var LogfmtSpecial = NewByteClass(func(b byte) bool {
+	return b <= ' ' || b == '=' || b == '"'
+})
+
+// ValidLogfmtKey reports whether s can be written as a logfmt key: it is
+// nonempty valid UTF-8 without bytes of LogfmtSpecial.
+func ValidLogfmtKey(s string) bool {
+	return s != "" && LogfmtSpecial.Index(s) < 0 && utf8.ValidString(s)
+}
+
+// AppendLogfmt appends s to dst as a logfmt value. The value is quoted
+// only if it contains a byte of LogfmtSpecial or invalid UTF-8. In a
+// quoted value, the double quote and backslash are escaped by a
+// backslash, LF, CR and tab as \n, \r and \t, the other control
+// characters as \u00XX, and each invalid UTF-8 byte as \ufffd.
+func AppendLogfmt(dst []byte, s string) []byte {
+	if LogfmtSpecial.Index(s) < 0 && utf8.ValidString(s) {
+		return append(dst, s...)
+	}
+	dst = append(dst, '"')
+	for {
+		i := IndexEscape(s, '"')
+		if i < 0 {
+			break
+		}
+		dst = append(dst, s[:i]...)
+		s = s[i:]
+		c := s[0]
+		if c >= utf8.RuneSelf {
+			r, n := utf8.DecodeRuneInString(s)
+			if r == utf8.RuneError && n == 1 {
+				dst = append(dst, `\ufffd`...)
+			} else {
+				dst = append(dst, s[:n]...)
+			}
+			s = s[n:]
+			continue
+		}
+		switch c {
+		case '"', '\\':
+			dst = append(dst, '\\', c)
+		case '\n':
+			dst = append(dst, `\n`...)
+		case '\r':
+			dst = append(dst, `\r`...)
+		case '\t':
+			dst = append(dst, `\t`...)
+		case 0x7F:
+			dst = append(dst, c)
+		default:
+			dst = append(dst, '\\', 'u', '0', '0', hexDigit(c>>4), hexDigit(c&0xF))
+		}
+		s = s[1:]
+	}
+	dst = append(dst, s...)
+	return append(dst, '"')
+}
@@ -6,6 +6,8 @@ package quoting
 
 import (
 	"unicode/utf8"
+
+	"github.com/mshafiee/fastparse/internal/unicode_tables"
 )
 
 // Quote modes
@@ -97,11 +99,12 @@ func quoteWith(s string, quote byte, mode int) string {
 	}
 
 	// Two-pass approach: calculate required size first
+	st := goStyle(quote)
 	size := 2 // Opening and closing quotes
 	for i := 0; i < len(s); {
 		r, width := utf8.DecodeRuneInString(s[i:])
 		i += width
-		size += runeEscapedSize(r, quote, mode)
+		size += runeEscapedSize(r, st, mode)
 	}
 
 	// Allocate buffer and build result
@@ -112,7 +115,7 @@ func quoteWith(s string, quote byte, mode int) string {
 	for i := 0; i < len(s); {
 		r, width := utf8.DecodeRuneInString(s[i:])
 		i += width
-		pos = appendEscapedRune(buf, pos, r, quote, mode)
+		pos = appendEscapedRune(buf, pos, r, st, mode)
 	}
 
 	buf[pos] = quote
@@ -130,11 +133,12 @@ func appendQuotedWith(dst []byte, s string, quote byte, mode int) []byte {
 	}
 
 	// Calculate required size
+	st := goStyle(quote)
 	size := 2
 	for i := 0; i < len(s); {
 		r, width := utf8.DecodeRuneInString(s[i:])
 		i += width
-		size += runeEscapedSize(r, quote, mode)
+		size += runeEscapedSize(r, st, mode)
 	}
 
 	// Grow buffer
@@ -148,7 +152,7 @@ func appendQuotedWith(dst []byte, s string, quote byte, mode int) []byte {
 	for i := 0; i < len(s); {
 		r, width := utf8.DecodeRuneInString(s[i:])
 		i += width
-		pos = appendEscapedRune(dst, pos, r, quote, mode)
+		pos = appendEscapedRune(dst, pos, r, st, mode)
 	}
 
 	dst[pos] = quote
@@ -157,22 +161,24 @@ func appendQuotedWith(dst []byte, s string, quote byte, mode int) []byte {
 
 // quoteRuneWith returns a quoted rune using the specified quote character and mode.
 func quoteRuneWith(r rune, quote byte, mode int) string {
-	size := 2 + runeEscapedSize(r, quote, mode)
+	st := goStyle(quote)
+	size := 2 + runeEscapedSize(r, st, mode)
 	buf := make([]byte, size)
 	buf[0] = quote
-	pos := appendEscapedRune(buf, 1, r, quote, mode)
+	pos := appendEscapedRune(buf, 1, r, st, mode)
 	buf[pos] = quote
 	return string(buf[:pos+1])
 }
 
 // appendQuotedRuneWith appends a quoted rune to dst.
 func appendQuotedRuneWith(dst []byte, r rune, quote byte, mode int) []byte {
-	size := 2 + runeEscapedSize(r, quote, mode)
+	st := goStyle(quote)
+	size := 2 + runeEscapedSize(r, st, mode)
 	oldLen := len(dst)
 	dst = append(dst, make([]byte, size)...)
 
 	dst[oldLen] = quote
-	pos := appendEscapedRune(dst, oldLen+1, r, quote, mode)
+	pos := appendEscapedRune(dst, oldLen+1, r, st, mode)
 	dst[pos] = quote
 	return dst[:pos+1]
 }
@@ -230,175 +236,43 @@ func checkNeedsEscapingGeneric(s string, quote byte, mode int) bool {
 	return false
 }
 
-// runeEscapedSize returns the number of bytes needed to represent the rune when escaped.
-func runeEscapedSize(r rune, quote byte, mode int) int {
-	// Check if rune needs escaping
-	if r == rune(quote) || r == '\\' {
-		return 2 // \q or \\
-	}
-
-	// Standard escape sequences
-	switch r {
-	case '\a', '\b', '\f', '\n', '\r', '\t', '\v':
-		return 2
-	}
-
-	// ASCII printable
+// runeEscapedSize returns the number of bytes needed to represent the rune
+// when escaped in style st.
+func runeEscapedSize(r rune, st *Style, mode int) int {
 	if r < utf8.RuneSelf {
+		if st.short[r] != 0 {
+			return 2 // \n, \" and the like
+		}
 		if r >= ' ' && r != 0x7F {
 			return 1
 		}
-		// Control characters: \xHH
-		return 4
-	}
-
-	// Non-ASCII
-	if mode == ModeASCII {
-		if r <= 0xFFFF {
-			return 6 // \uHHHH
-		}
-		return 10 // \UHHHHHHHH
+		return st.escapeSize(r)
 	}
-
-	// Check if printable/graphic
-	needsEscape := false
-	if mode == ModeGraphic {
-		needsEscape = !isGraphic(r)
-	} else {
-		needsEscape = !isPrint(r)
+	if st.mustEscape(r, mode) {
+		return st.escapeSize(r)
 	}
-
-	if needsEscape {
-		if r <= 0xFFFF {
-			return 6 // \uHHHH
-		}
-		return 10 // \UHHHHHHHH
-	}
-
 	// Valid UTF-8 rune, return its size
 	return utf8.RuneLen(r)
 }
 
-// appendEscapedRune appends an escaped rune to buf starting at pos.
-// Returns the new position.
-func appendEscapedRune(buf []byte, pos int, r rune, quote byte, mode int) int {
-	// Quote character or backslash
-	if r == rune(quote) {
-		buf[pos] = '\\'
-		buf[pos+1] = quote
-		return pos + 2
-	}
-	if r == '\\' {
-		buf[pos] = '\\'
-		buf[pos+1] = '\\'
-		return pos + 2
-	}
-
-	// Standard escape sequences
-	switch r {
-	case '\a':
-		buf[pos] = '\\'
-		buf[pos+1] = 'a'
-		return pos + 2
-	case '\b':
-		buf[pos] = '\\'
-		buf[pos+1] = 'b'
-		return pos + 2
-	case '\f':
-		buf[pos] = '\\'
-		buf[pos+1] = 'f'
-		return pos + 2
-	case '\n':
-		buf[pos] = '\\'
-		buf[pos+1] = 'n'
-		return pos + 2
-	case '\r':
-		buf[pos] = '\\'
-		buf[pos+1] = 'r'
-		return pos + 2
-	case '\t':
-		buf[pos] = '\\'
-		buf[pos+1] = 't'
-		return pos + 2
-	case '\v':
-		buf[pos] = '\\'
-		buf[pos+1] = 'v'
-		return pos + 2
-	}
-
-	// ASCII printable
+// appendEscapedRune appends the rune, escaped in style st, to buf starting
+// at pos. Returns the new position.
+func appendEscapedRune(buf []byte, pos int, r rune, st *Style, mode int) int {
 	if r < utf8.RuneSelf {
+		if c := st.short[r]; c != 0 {
+			buf[pos] = '\\'
+			buf[pos+1] = c
+			return pos + 2
+		}
 		if r >= ' ' && r != 0x7F {
 			buf[pos] = byte(r)
 			return pos + 1
 		}
-		// Control characters: \xHH
-		buf[pos] = '\\'
-		buf[pos+1] = 'x'
-		buf[pos+2] = hexDigit(byte(r) >> 4)
-		buf[pos+3] = hexDigit(byte(r) & 0xF)
-		return pos + 4
+		return st.appendEscape(buf, pos, r)
 	}
-
-	// Non-ASCII
-	if mode == ModeASCII {
-		if r <= 0xFFFF {
-			// \uHHHH
-			buf[pos] = '\\'
-			buf[pos+1] = 'u'
-			buf[pos+2] = hexDigit(byte(r >> 12))
-			buf[pos+3] = hexDigit(byte(r >> 8 & 0xF))
-			buf[pos+4] = hexDigit(byte(r >> 4 & 0xF))
-			buf[pos+5] = hexDigit(byte(r & 0xF))
-			return pos + 6
-		}
-		// \UHHHHHHHH
-		buf[pos] = '\\'
-		buf[pos+1] = 'U'
-		buf[pos+2] = hexDigit(byte(r >> 28))
-		buf[pos+3] = hexDigit(byte(r >> 24 & 0xF))
-		buf[pos+4] = hexDigit(byte(r >> 20 & 0xF))
-		buf[pos+5] = hexDigit(byte(r >> 16 & 0xF))
-		buf[pos+6] = hexDigit(byte(r >> 12 & 0xF))
-		buf[pos+7] = hexDigit(byte(r >> 8 & 0xF))
-		buf[pos+8] = hexDigit(byte(r >> 4 & 0xF))
-		buf[pos+9] = hexDigit(byte(r & 0xF))
-		return pos + 10
-	}
-
-	// Check if printable/graphic
-	needsEscape := false
-	if mode == ModeGraphic {
-		needsEscape = !isGraphic(r)
-	} else {
-		needsEscape = !isPrint(r)
+	if st.mustEscape(r, mode) {
+		return st.appendEscape(buf, pos, r)
 	}
-
-	if needsEscape {
-		if r <= 0xFFFF {
-			// \uHHHH
-			buf[pos] = '\\'
-			buf[pos+1] = 'u'
-			buf[pos+2] = hexDigit(byte(r >> 12))
-			buf[pos+3] = hexDigit(byte(r >> 8 & 0xF))
-			buf[pos+4] = hexDigit(byte(r >> 4 & 0xF))
-			buf[pos+5] = hexDigit(byte(r & 0xF))
-			return pos + 6
-		}
-		// \UHHHHHHHH
-		buf[pos] = '\\'
-		buf[pos+1] = 'U'
-		buf[pos+2] = hexDigit(byte(r >> 28))
-		buf[pos+3] = hexDigit(byte(r >> 24 & 0xF))
-		buf[pos+4] = hexDigit(byte(r >> 20 & 0xF))
-		buf[pos+5] = hexDigit(byte(r >> 16 & 0xF))
-		buf[pos+6] = hexDigit(byte(r >> 12 & 0xF))
-		buf[pos+7] = hexDigit(byte(r >> 8 & 0xF))
-		buf[pos+8] = hexDigit(byte(r >> 4 & 0xF))
-		buf[pos+9] = hexDigit(byte(r & 0xF))
-		return pos + 10
-	}
-
 	// Valid UTF-8 rune, encode it
 	return pos + utf8.EncodeRune(buf[pos:], r)
 }
@@ -411,30 +285,12 @@ func hexDigit(b byte) byte {
 	return 'a' + b - 10
 }
 
-// isPrint reports whether the rune is printable (from Unicode package).
+// isPrint reports whether the rune is printable, as strconv.IsPrint does.
 func isPrint(r rune) bool {
-	// This will be replaced with optimized lookup table
-	if r < 0x20 || r == 0x7F {
-		return false
-	}
-	if r < 0x7F {
-		return true
-	}
-	// For now, accept all non-ASCII as printable
-	// TODO: Use proper Unicode tables
-	return r != utf8.RuneError
+	return unicode_tables.IsPrint(r)
 }
 
-// isGraphic reports whether the rune is graphic (from Unicode package).
+// isGraphic reports whether the rune is graphic, as strconv.IsGraphic does.
 func isGraphic(r rune) bool {
-	// This will be replaced with optimized lookup table
-	if r < 0x20 || r == 0x7F {
-		return false
-	}
-	if r < 0x7F {
-		return r != ' ' // Space is not graphic
-	}
-	// For now, accept all non-ASCII as graphic
-	// TODO: Use proper Unicode tables
-	return r != utf8.RuneError
+	return unicode_tables.IsGraphic(r)
 }
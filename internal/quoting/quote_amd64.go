@@ -6,9 +6,7 @@
 
 package quoting
 
-import (
-	"github.com/mshafiee/fastparse"
-)
+import "github.com/mshafiee/fastparse/internal/dispatch"
 
 // hasASM indicates whether assembly implementation is available
 const hasASM = true
@@ -19,10 +17,15 @@ func needsEscapingASM(s string, quote byte, mode int) bool
 //go:noescape
 func needsEscapingAVX512(s string, quote byte, mode int) bool
 
-// needsEscapingOptimized dispatches to the best available SIMD implementation
+// needsEscapingOptimized dispatches to the best allowed SIMD implementation
 func needsEscapingOptimized(s string, quote byte, mode int) bool {
-	// Try AVX-512 for very long strings on supported CPUs
-	if len(s) >= 64 && fastparse.HasAVX512() {
+	if !dispatch.Allow(dispatch.AVX2) {
+		return checkNeedsEscapingGeneric(s, quote, mode)
+	}
+
+	// Try AVX-512 for very long strings on supported CPUs; its byte
+	// compares need AVX-512BW, which the AVX512 level implies
+	if len(s) >= 64 && dispatch.Allow(dispatch.AVX512) {
 		// AVX-512 processes 64 bytes at a time
 		// For strings >= 64 bytes, use AVX-512 then fall back to AVX2 for remainder
 		if needsEscapingAVX512(s, quote, mode) {
@@ -8,7 +8,7 @@
 
 // func needsEscapingASM(s string, quote byte, mode int) bool
 // AVX2-optimized version that processes 32 bytes at a time
-TEXT ·needsEscapingASM(SB), NOSPLIT, $0-34
+TEXT ·needsEscapingASM(SB), NOSPLIT, $0-33
 	// Get string pointer and length
 	MOVQ s_base+0(FP), SI    // SI = string data pointer
 	MOVQ s_len+8(FP), CX     // CX = string length
@@ -26,27 +26,27 @@ TEXT ·needsEscapingASM(SB), NOSPLIT, $0-34
 	// Prepare AVX2 constants
 	// Broadcast quote character to all 32 bytes of Y0
 	MOVB DL, AX
-	MOVQ AX, X0
+	VMOVQ AX, X0
 	VPBROADCASTB X0, Y0      // Y0 = quote repeated 32 times
 	
 	// Y1 = backslash repeated 32 times
 	MOVQ $0x5C, AX           // '\' = 0x5C
-	MOVQ AX, X1
+	VMOVQ AX, X1
 	VPBROADCASTB X1, Y1
 	
 	// Y2 = space (0x20) repeated 32 times (for < check)
 	MOVQ $0x20, AX
-	MOVQ AX, X2
+	VMOVQ AX, X2
 	VPBROADCASTB X2, Y2
 	
 	// Y3 = DEL (0x7F) repeated 32 times
 	MOVQ $0x7F, AX
-	MOVQ AX, X3
+	VMOVQ AX, X3
 	VPBROADCASTB X3, Y3
 	
 	// Y4 = 0x80 for non-ASCII check
 	MOVQ $0x80, AX
-	MOVQ AX, X4
+	VMOVQ AX, X4
 	VPBROADCASTB X4, Y4
 	
 	XORQ DX, DX              // DX = index
@@ -113,6 +113,7 @@ avx2_needs_escape:
 
 sse2_path:
 	// SSE2 path for 16-31 byte strings
+	XORQ DX, DX              // DX = index, also for the scalar path
 	CMPQ CX, $16
 	JL scalar_path
 	
@@ -145,8 +146,6 @@ sse2_path:
 	PUNPCKLWL X3, X3
 	PSHUFD $0, X3, X3
 	
-	XORQ DX, DX
-	
 sse2_loop:
 	MOVQ CX, BX
 	SUBQ DX, BX
@@ -175,8 +174,8 @@ sse2_loop:
 	MOVOU (SI)(DX*1), X4
 	
 	// Check < space
-	MOVOA X4, X5
-	PCMPGTB X2, X5           // X5 = (X4 < X2)
+	MOVOA X2, X5
+	PCMPGTB X4, X5           // X5 = (X4 < X2)
 	PMOVMSKB X5, AX
 	TESTL AX, AX
 	JNZ sse2_needs_escape
@@ -210,12 +209,12 @@ scalar_path:
 	// Scalar loop for remaining bytes or short strings
 	CMPQ DX, CX
 	JGE no_escape
+	MOVB quote+16(FP), BX
 	
 scalar_loop:
 	MOVBLZX (SI)(DX*1), AX
 	
 	// Check quote
-	MOVB quote+16(FP), BX
 	CMPB AL, BL
 	JE needs_escape
 	
@@ -223,20 +222,15 @@ scalar_loop:
 	CMPB AL, $0x5C
 	JE needs_escape
 	
-	// Check < space
+	// Check < space; the signed compare also catches non-ASCII
+	// bytes (>= 0x80) in every mode, like the vector paths
 	CMPB AL, $0x20
-	JL needs_escape
+	JLT needs_escape
 	
 	// Check DEL
 	CMPB AL, $0x7F
 	JE needs_escape
 	
-	// Check non-ASCII
-	CMPQ R8, $1
-	JNE scalar_next
-	CMPB AL, $0x80
-	JGE needs_escape
-	
 scalar_next:
 	INCQ DX
 	CMPQ DX, CX
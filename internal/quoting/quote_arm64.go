@@ -6,6 +6,8 @@
 
 package quoting
 
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
 // hasASM indicates whether assembly implementation is available
 const hasASM = true
 
@@ -14,5 +16,8 @@ func needsEscapingASM(s string, quote byte, mode int) bool
 
 // needsEscapingOptimized uses ARM NEON optimizations (no AVX-512 on ARM)
 func needsEscapingOptimized(s string, quote byte, mode int) bool {
+	if !dispatch.Allow(dispatch.NEON) {
+		return checkNeedsEscapingGeneric(s, quote, mode)
+	}
 	return needsEscapingASM(s, quote, mode)
 }
@@ -9,7 +9,7 @@
 // func needsEscapingAVX512(s string, quote byte, mode int) bool
 // AVX-512 version that processes 64 bytes at a time
 // Only called if CPU supports AVX-512
-TEXT ·needsEscapingAVX512(SB), NOSPLIT, $0-34
+TEXT ·needsEscapingAVX512(SB), NOSPLIT, $0-33
 	// Get string pointer and length
 	MOVQ s_base+0(FP), SI    // SI = string data pointer
 	MOVQ s_len+8(FP), CX     // CX = string length
@@ -6,112 +6,6 @@
 
 #include "textflag.h"
 
-// func needsEscapingAVX512(s string, quote byte, mode int) bool
-// AVX-512 optimized version that processes 64 bytes at a time
-// Uses AVX-512 BW for byte/word operations
-TEXT ·needsEscapingAVX512(SB), NOSPLIT, $0-34
-	// Get string pointer and length
-	MOVQ s_base+0(FP), SI    // SI = string data pointer
-	MOVQ s_len+8(FP), CX     // CX = string length
-	MOVB quote+16(FP), DX    // DL = quote character
-	MOVQ mode+24(FP), R8     // R8 = mode
-	
-	// Fast path: empty string needs no escaping
-	TESTQ CX, CX
-	JZ no_escape
-	
-	// Require at least 64 bytes for AVX-512 path
-	CMPQ CX, $64
-	JL fallback
-	
-	// Prepare AVX-512 constants
-	// Broadcast quote character to all 64 bytes of Z0
-	MOVB DL, AX
-	VPBROADCASTB AX, Z0      // Z0 = quote repeated 64 times
-	
-	// Z1 = backslash (0x5C) repeated 64 times
-	MOVQ $0x5C, AX
-	VPBROADCASTB AX, Z1
-	
-	// Z2 = space (0x20) for control character check
-	MOVQ $0x20, AX
-	VPBROADCASTB AX, Z2
-	
-	// Z3 = DEL (0x7F)
-	MOVQ $0x7F, AX
-	VPBROADCASTB AX, Z3
-	
-	XORQ DX, DX              // DX = index
-	
-avx512_loop:
-	// Check if we have at least 64 bytes left
-	MOVQ CX, BX
-	SUBQ DX, BX
-	CMPQ BX, $64
-	JL avx512_remainder
-	
-	// Load 64 bytes
-	VMOVDQU8 (SI)(DX*1), Z4
-	
-	// Check 1: byte == quote
-	VPCMPEQB Z0, Z4, K1
-	KORTESTQ K1, K1
-	JNZ avx512_needs_escape
-	
-	// Check 2: byte == backslash
-	VPCMPEQB Z1, Z4, K1
-	KORTESTQ K1, K1
-	JNZ avx512_needs_escape
-	
-	// Check 3: byte < ' ' (control characters)
-	VPCMPUB $1, Z2, Z4, K1   // K1 = mask where Z4 < Z2 (compare LT)
-	KORTESTQ K1, K1
-	JNZ avx512_needs_escape
-	
-	// Check 4: byte == 0x7F (DEL)
-	VPCMPEQB Z3, Z4, K1
-	KORTESTQ K1, K1
-	JNZ avx512_needs_escape
-	
-	// Check 5: byte >= 0x80 (non-ASCII) if mode == ModeASCII (1)
-	CMPQ R8, $1
-	JNE avx512_next
-	
-	// Check high bit: create mask of bytes with bit 7 set
-	MOVQ $0x80, AX
-	VPBROADCASTB AX, Z5
-	VPTESTMB Z5, Z4, K1      // K1 = mask where (Z4 & 0x80) != 0
-	KORTESTQ K1, K1
-	JNZ avx512_needs_escape
-	
-avx512_next:
-	ADDQ $64, DX
-	JMP avx512_loop
-	
-avx512_remainder:
-	// Process remaining bytes with smaller chunks
-	VZEROUPPER
-	JMP fallback_remainder
-	
-avx512_needs_escape:
-	VZEROUPPER
-	MOVB $1, ret+32(FP)
-	RET
-	
-fallback:
-	// Not enough bytes for AVX-512, return false to use fallback
-	MOVB $0, ret+32(FP)
-	RET
-	
-fallback_remainder:
-	// Process remaining bytes with scalar or AVX2
-	MOVB $0, ret+32(FP)
-	RET
-	
-no_escape:
-	MOVB $0, ret+32(FP)
-	RET
-
 // func quoteWithAVX512(dst []byte, s string, quote byte) int
 // AVX-512 optimized quoting that uses VPCOMPRESSB for selective escaping
 // Returns number of bytes written to dst
@@ -0,0 +1,56 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quoting
+
+import "strings"
+
+// POSIX shell words.
+
+// ShellUnsafe holds the bytes that cannot appear in a bare shell word:
+// everything except ASCII letters, digits and @%+=:,./-_, the same set as
+// Python's shlex.quote.
+var ShellUnsafe = NewByteClass(func(b byte) bool {
+	switch {
+	case 'a' <= b && b <= 'z', 'A' <= b && b <= 'Z', '0' <= b && b <= '9':
+		return false
+	}
+	return strings.IndexByte("@%+=:,./-_", b) < 0
+})
+
+// ShellSpecial holds the bytes that end a run of literal characters when
+// splitting shell words: blanks, quotes and backslash.
+var ShellSpecial = NewByteClass(func(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\'' || b == '"' || b == '\\'
+})
+
+// AppendShell appends s to dst as a single POSIX shell word. Safe words
+// are appended as is, the empty string as a pair of single quotes, and
+// anything else in single quotes, with each embedded single quote written
+// as \' between the quoted runs.
+func AppendShell(dst []byte, s string) []byte {
+	if s == "" {
+		return append(dst, '\'', '\'')
+	}
+	if ShellUnsafe.Index(s) < 0 {
+		return append(dst, s...)
+	}
+	for {
+		i := strings.IndexByte(s, '\'')
+		seg := s
+		if i >= 0 {
+			seg = s[:i]
+		}
+		if seg != "" {
+			dst = append(dst, '\'')
+			dst = append(dst, seg...)
+			dst = append(dst, '\'')
+		}
+		if i < 0 {
+			return dst
+		}
+		dst = append(dst, '\\', '\'')
+		s = s[i+1:]
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quoting
+
+import (
+	"math/bits"
+	"strings"
+	"unicode/utf8"
+)
+
+// String literals of other languages.
+
+// Style describes the string literal syntax of a target language: the
+// quote character, which ASCII characters have a short escape such as \n,
+// and how every other character that must be escaped is written.
+type Style struct {
+	quote byte
+	mode  int
+	form  int // escape form for characters without a short escape
+	lang  int // literal grammar, for unquoting
+
+	// short holds the letter of the short escape of each ASCII character,
+	// or 0 if it has none. The quote and backslash escape themselves.
+	short [utf8.RuneSelf]byte
+
+	trigraphs bool // escape the second ? of ?? so that no trigraph forms
+	template  bool // escape $ before { so that no substitution forms
+	lineSeps  bool // escape U+2028 and U+2029
+}
+
+// Escape forms.
+const (
+	escGo     = iota // \xHH below U+0080, then \uHHHH and \UHHHHHHHH
+	escOctal         // \ooo for every byte; runes are bytes
+	escPython        // \xHH below U+0100, then \uHHHH and \UHHHHHHHH
+	escRust          // \xHH below U+0080, then \u{h...}
+	escJS            // \xHH below U+0100, \uHHHH, then \u{h...}
+)
+
+// Literal grammars.
+const (
+	langGo = iota
+	langC
+	langPython
+	langRust
+	langJS
+	langJSTemplate
+)
+
+// shortValue holds the character written by each short escape letter.
+var shortValue = [utf8.RuneSelf]byte{
+	'0': 0, 'a': '\a', 'b': '\b', 'f': '\f', 'n': '\n', 'r': '\r', 't': '\t', 'v': '\v',
+}
+
+// newStyle returns a style with the short escapes named by letters.
+func newStyle(quote byte, mode, form, lang int, letters string) *Style {
+	st := &Style{quote: quote, mode: mode, form: form, lang: lang}
+	for i := 0; i < len(letters); i++ {
+		st.short[shortValue[letters[i]]] = letters[i]
+	}
+	st.short[quote] = quote
+	st.short['\\'] = '\\'
+	return st
+}
+
+var (
+	goString = newStyle('"', ModePrint, escGo, langGo, "abfnrtv")
+	goRune   = newStyle('\'', ModePrint, escGo, langGo, "abfnrtv")
+
+	// CStyle writes C string literals. C strings are bytes, so every byte
+	// outside printable ASCII is written as a three-digit octal escape,
+	// which unlike \x cannot run on into a following digit.
+	CStyle = func() *Style {
+		st := newStyle('"', ModeASCII, escOctal, langC, "abfnrtv")
+		st.trigraphs = true
+		return st
+	}()
+
+	// PythonStyle writes Python 3 str literals.
+	PythonStyle = newStyle('"', ModePrint, escPython, langPython, "abfnrtv")
+
+	// RustStyle writes Rust string literals, which have no \a, \b, \f or
+	// \v and only allow \x below U+0080.
+	RustStyle = newStyle('"', ModePrint, escRust, langRust, "nrt0")
+
+	// JSStyle writes JavaScript string literals. \0 is avoided because it
+	// reads as a legacy octal escape when a digit follows.
+	JSStyle = func() *Style {
+		st := newStyle('"', ModePrint, escJS, langJS, "bfnrtv")
+		st.lineSeps = true
+		return st
+	}()
+
+	// JSTemplateStyle writes JavaScript template literals without
+	// substitutions.
+	JSTemplateStyle = func() *Style {
+		st := newStyle('`', ModePrint, escJS, langJSTemplate, "bfnrtv")
+		st.template = true
+		st.lineSeps = true
+		return st
+	}()
+)
+
+// goStyle returns the Go literal style for the quote character.
+func goStyle(quote byte) *Style {
+	if quote == '\'' {
+		return goRune
+	}
+	return goString
+}
+
+// mustEscape reports whether the non-ASCII rune r is escaped in mode.
+func (st *Style) mustEscape(r rune, mode int) bool {
+	switch {
+	case mode == ModeASCII:
+		return true
+	case st.lineSeps && (r == 0x2028 || r == 0x2029):
+		return true
+	case mode == ModeGraphic:
+		return !isGraphic(r)
+	}
+	return !isPrint(r)
+}
+
+// braces reports whether r is escaped as \u{h...}.
+func (st *Style) braces(r rune) bool {
+	return st.form == escRust && r >= utf8.RuneSelf || st.form == escJS && r > 0xFFFF
+}
+
+// escapeSize returns the length of the escape of r in the style's form.
+func (st *Style) escapeSize(r rune) int {
+	switch {
+	case st.form == escOctal:
+		return 4 // \ooo
+	case st.braces(r):
+		return 4 + (bits.Len32(uint32(r))+3)/4 // \u{h...}
+	case r < utf8.RuneSelf || r <= 0xFF && st.form != escGo:
+		return 4 // \xHH
+	case r <= 0xFFFF:
+		return 6 // \uHHHH
+	}
+	return 10 // \UHHHHHHHH
+}
+
+// appendEscape writes the escape of r in the style's form to buf starting
+// at pos. Returns the new position.
+func (st *Style) appendEscape(buf []byte, pos int, r rune) int {
+	buf[pos] = '\\'
+	if st.form == escOctal {
+		buf[pos+1] = '0' + byte(r>>6&7)
+		buf[pos+2] = '0' + byte(r>>3&7)
+		buf[pos+3] = '0' + byte(r&7)
+		return pos + 4
+	}
+	n := st.escapeSize(r)
+	if st.braces(r) {
+		buf[pos+1] = 'u'
+		buf[pos+2] = '{'
+		for i := 3; i < n-1; i++ {
+			buf[pos+i] = hexDigit(byte(r >> (4 * (n - 2 - i)) & 0xF))
+		}
+		buf[pos+n-1] = '}'
+		return pos + n
+	}
+	switch n {
+	case 4:
+		buf[pos+1] = 'x'
+	case 6:
+		buf[pos+1] = 'u'
+	default:
+		buf[pos+1] = 'U'
+	}
+	for i := 2; i < n; i++ {
+		buf[pos+i] = hexDigit(byte(r >> (4 * (n - 1 - i)) & 0xF))
+	}
+	return pos + n
+}
+
+// decodeRune returns the first character of s: a byte in the octal form,
+// and a UTF-8 encoded rune otherwise.
+func (st *Style) decodeRune(s string) (rune, int) {
+	if st.form == escOctal {
+		return rune(s[0]), 1
+	}
+	return utf8.DecodeRuneInString(s)
+}
+
+// contextEscape reports whether s[i] is escaped because of its neighbours:
+// the second ? of ?? in C, and a $ before { in template literals.
+func (st *Style) contextEscape(s string, i int) bool {
+	switch s[i] {
+	case '?':
+		return st.trigraphs && i > 0 && s[i-1] == '?'
+	case '$':
+		return st.template && i+1 < len(s) && s[i+1] == '{'
+	}
+	return false
+}
+
+// needsEscaping reports whether s cannot be quoted as is.
+func (st *Style) needsEscaping(s string) bool {
+	return checkNeedsEscaping(s, st.quote, st.mode) ||
+		st.trigraphs && strings.Contains(s, "??") ||
+		st.template && strings.Contains(s, "${") ||
+		st.lineSeps && (strings.ContainsRune(s, 0x2028) || strings.ContainsRune(s, 0x2029))
+}
+
+// AppendStyle appends s to dst as a string literal in style st.
+//
+// Except in C, where invalid UTF-8 bytes are escaped as they are, each
+// invalid byte is written as an escaped U+FFFD.
+func AppendStyle(dst []byte, s string, st *Style) []byte {
+	if !st.needsEscaping(s) {
+		dst = append(dst, st.quote)
+		dst = append(dst, s...)
+		return append(dst, st.quote)
+	}
+
+	size := 2
+	for i := 0; i < len(s); {
+		r, width := st.decodeRune(s[i:])
+		switch {
+		case st.contextEscape(s, i):
+			size += 2
+		case r == utf8.RuneError && width == 1:
+			size += st.escapeSize(r)
+		default:
+			size += runeEscapedSize(r, st, st.mode)
+		}
+		i += width
+	}
+
+	oldLen := len(dst)
+	dst = append(dst, make([]byte, size)...)
+	dst[oldLen] = st.quote
+	pos := oldLen + 1
+	for i := 0; i < len(s); {
+		r, width := st.decodeRune(s[i:])
+		switch {
+		case st.contextEscape(s, i):
+			dst[pos] = '\\'
+			dst[pos+1] = s[i]
+			pos += 2
+		case r == utf8.RuneError && width == 1:
+			pos = st.appendEscape(dst, pos, r)
+		default:
+			pos = appendEscapedRune(dst, pos, r, st, st.mode)
+		}
+		i += width
+	}
+	dst[pos] = st.quote
+	return dst[:pos+1]
+}
@@ -0,0 +1,393 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quoting
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// AppendUnquoteStyle appends the value of the string literal s, written in
+// style st, to dst.
+//
+// Each style accepts the escapes of its language, including those it never
+// writes, such as \x in C and \u in Python. Python \N{name} escapes are not
+// supported, since they need the Unicode name database. Lone surrogates,
+// which Go strings cannot hold, become U+FFFD. If s is not a valid
+// literal, ok is false and dst is returned unchanged.
+func AppendUnquoteStyle(dst []byte, s string, st *Style) (_ []byte, ok bool) {
+	n := len(dst)
+	if len(s) < 2 || s[0] != s[len(s)-1] || !st.isQuote(s[0]) {
+		return dst, false
+	}
+	quote := s[0]
+	s = s[1 : len(s)-1]
+	multiline := st.lang == langRust || st.lang == langJSTemplate
+
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\':
+			dst = append(dst, s[start:i]...)
+			var w int
+			switch st.lang {
+			case langC:
+				dst, w = unescapeC(dst, s[i:])
+			case langPython:
+				dst, w = unescapePython(dst, s[i:])
+			case langRust:
+				dst, w = unescapeRust(dst, s[i:])
+			default:
+				dst, w = unescapeJS(dst, s[i:])
+			}
+			if w < 0 {
+				return dst[:n], false
+			}
+			i += w
+			start = i
+		case c == quote:
+			return dst[:n], false
+		case c == '\n':
+			if !multiline {
+				return dst[:n], false
+			}
+			i++
+		case c == '\r':
+			// Line breaks read as \n. Rust allows a CR only before an LF.
+			crlf := i+1 < len(s) && s[i+1] == '\n'
+			if !multiline || st.lang == langRust && !crlf {
+				return dst[:n], false
+			}
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\n')
+			i++
+			if crlf {
+				i++
+			}
+			start = i
+		case c == '$' && st.lang == langJSTemplate && i+1 < len(s) && s[i+1] == '{':
+			return dst[:n], false
+		case c < utf8.RuneSelf || st.lang == langC:
+			i++
+		default:
+			r, width := utf8.DecodeRuneInString(s[i:])
+			if r == utf8.RuneError && width == 1 {
+				return dst[:n], false
+			}
+			i += width
+		}
+	}
+	return append(dst, s[start:]...), true
+}
+
+// isQuote reports whether a literal in the style may be quoted with q.
+func (st *Style) isQuote(q byte) bool {
+	switch st.lang {
+	case langPython, langJS:
+		return q == '"' || q == '\''
+	}
+	return q == st.quote
+}
+
+// unescapeC decodes the C escape sequence at the start of s. It returns
+// the number of bytes consumed, or -1 if the escape is invalid.
+func unescapeC(dst []byte, s string) ([]byte, int) {
+	if len(s) < 2 {
+		return dst, -1
+	}
+	switch c := s[1]; c {
+	case '\'', '"', '?', '\\':
+		return append(dst, c), 2
+	case 'a', 'b', 'f', 'n', 'r', 't', 'v':
+		return append(dst, shortValue[c]), 2
+	case '\n':
+		return dst, 2 // line splice
+	case '\r':
+		if len(s) > 2 && s[2] == '\n' {
+			return dst, 3
+		}
+		return dst, 2
+	case 'x':
+		// A hex escape takes every hex digit that follows; the value must
+		// still fit in a byte.
+		var v rune
+		i := 2
+		for ; i < len(s); i++ {
+			d, ok := unhex(s[i])
+			if !ok {
+				break
+			}
+			if v = v<<4 | d; v > 0xFF {
+				return dst, -1
+			}
+		}
+		if i == 2 {
+			return dst, -1
+		}
+		return append(dst, byte(v)), i
+	case 'u', 'U':
+		n := 4
+		if c == 'U' {
+			n = 8
+		}
+		// Universal character names cannot name surrogates or, apart from
+		// $, @ and `, anything below U+00A0.
+		r, ok := unhexN(s[2:], n)
+		if !ok || utf16.IsSurrogate(r) || r < 0xA0 && r != '$' && r != '@' && r != '`' {
+			return dst, -1
+		}
+		return utf8.AppendRune(dst, r), 2 + n
+	}
+	if !isOctal(s[1]) {
+		return dst, -1
+	}
+	v, i := octalPrefix(s)
+	if v > 0xFF {
+		return dst, -1
+	}
+	return append(dst, byte(v)), i
+}
+
+// unescapePython decodes the Python escape sequence at the start of s. It
+// returns the number of bytes consumed, or -1 if the escape is invalid.
+func unescapePython(dst []byte, s string) ([]byte, int) {
+	if len(s) < 2 {
+		return dst, -1
+	}
+	switch c := s[1]; c {
+	case '\\', '\'', '"':
+		return append(dst, c), 2
+	case 'a', 'b', 'f', 'n', 'r', 't', 'v':
+		return append(dst, shortValue[c]), 2
+	case '\n':
+		return dst, 2 // line continuation
+	case '\r':
+		if len(s) > 2 && s[2] == '\n' {
+			return dst, 3
+		}
+		return dst, 2
+	case 'x', 'u', 'U':
+		n := 2
+		switch c {
+		case 'u':
+			n = 4
+		case 'U':
+			n = 8
+		}
+		r, ok := unhexN(s[2:], n)
+		if !ok {
+			return dst, -1
+		}
+		if utf16.IsSurrogate(r) {
+			r = utf8.RuneError
+		}
+		return utf8.AppendRune(dst, r), 2 + n
+	case 'N':
+		return dst, -1
+	}
+	if isOctal(s[1]) {
+		v, i := octalPrefix(s)
+		return utf8.AppendRune(dst, v), i
+	}
+	// Unrecognized escapes are left in the string, backslash included.
+	return append(dst, '\\'), 1
+}
+
+// unescapeRust decodes the Rust escape sequence at the start of s. It
+// returns the number of bytes consumed, or -1 if the escape is invalid.
+func unescapeRust(dst []byte, s string) ([]byte, int) {
+	if len(s) < 2 {
+		return dst, -1
+	}
+	switch c := s[1]; c {
+	case '\\', '\'', '"':
+		return append(dst, c), 2
+	case '0', 'n', 'r', 't':
+		return append(dst, shortValue[c]), 2
+	case 'x':
+		r, ok := unhexN(s[2:], 2)
+		if !ok || r >= utf8.RuneSelf {
+			return dst, -1
+		}
+		return append(dst, byte(r)), 4
+	case 'u':
+		r, w := unbrace(s[2:], true)
+		if w < 0 || utf16.IsSurrogate(r) {
+			return dst, -1
+		}
+		return utf8.AppendRune(dst, r), 2 + w
+	case '\r', '\n':
+		// A line continuation also skips the whitespace that follows.
+		if c == '\r' && (len(s) < 3 || s[2] != '\n') {
+			return dst, -1
+		}
+		i := 2
+		for i < len(s) && (s[i] == ' ' || s[i] == '\t' || s[i] == '\n' || s[i] == '\r') {
+			i++
+		}
+		return dst, i
+	}
+	return dst, -1
+}
+
+// unescapeJS decodes the JavaScript escape sequence at the start of s,
+// joining a surrogate pair written as two escapes. It returns the number
+// of bytes consumed, or -1 if the escape is invalid.
+func unescapeJS(dst []byte, s string) ([]byte, int) {
+	r, w := jsEscape(s)
+	if w < 0 || r < 0 {
+		return dst, w
+	}
+	if utf16.IsSurrogate(r) {
+		if r < 0xDC00 && len(s) > w && s[w] == '\\' {
+			if r2, w2 := jsEscape(s[w:]); w2 > 0 && 0xDC00 <= r2 && r2 <= 0xDFFF {
+				return utf8.AppendRune(dst, utf16.DecodeRune(r, r2)), w + w2
+			}
+		}
+		r = utf8.RuneError
+	}
+	return utf8.AppendRune(dst, r), w
+}
+
+// jsEscape decodes the JavaScript escape sequence at the start of s,
+// returning the UTF-16 code unit or code point it denotes and its length.
+// A line continuation denotes no character and returns r < 0; an invalid
+// escape returns w < 0.
+func jsEscape(s string) (r rune, w int) {
+	if len(s) < 2 {
+		return 0, -1
+	}
+	switch c := s[1]; c {
+	case 'b', 'f', 'n', 'r', 't', 'v':
+		return rune(shortValue[c]), 2
+	case '0':
+		// \0 followed by a digit is a legacy octal escape, which strict
+		// code and template literals reject.
+		if len(s) > 2 && '0' <= s[2] && s[2] <= '9' {
+			return 0, -1
+		}
+		return 0, 2
+	case '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return 0, -1
+	case 'x':
+		if r, ok := unhexN(s[2:], 2); ok {
+			return r, 4
+		}
+		return 0, -1
+	case 'u':
+		if len(s) > 2 && s[2] == '{' {
+			r, w := unbrace(s[2:], false)
+			if w < 0 {
+				return 0, -1
+			}
+			return r, 2 + w
+		}
+		if r, ok := unhexN(s[2:], 4); ok {
+			return r, 6
+		}
+		return 0, -1
+	case '\n':
+		return -1, 2
+	case '\r':
+		if len(s) > 2 && s[2] == '\n' {
+			return -1, 3
+		}
+		return -1, 2
+	default:
+		if c < utf8.RuneSelf {
+			return rune(c), 2
+		}
+	}
+	// Any other character escapes itself, except that the line separators
+	// continue the line.
+	r, width := utf8.DecodeRuneInString(s[1:])
+	switch {
+	case r == utf8.RuneError && width == 1:
+		return 0, -1
+	case r == 0x2028 || r == 0x2029:
+		return -1, 1 + width
+	}
+	return r, 1 + width
+}
+
+// unbrace decodes the {h...} part of a \u{h...} escape at the start of s,
+// returning the code point and the number of bytes consumed, or w < 0.
+// Rust allows underscores after the first digit and at most six digits.
+func unbrace(s string, rust bool) (r rune, w int) {
+	if len(s) < 3 || s[0] != '{' {
+		return 0, -1
+	}
+	digits := 0
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		if c == '}' {
+			if digits == 0 {
+				return 0, -1
+			}
+			return r, i + 1
+		}
+		if c == '_' && rust && digits > 0 {
+			continue
+		}
+		d, ok := unhex(c)
+		if digits++; !ok || rust && digits > 6 {
+			return 0, -1
+		}
+		if r = r<<4 | d; r > utf8.MaxRune {
+			return 0, -1
+		}
+	}
+	return 0, -1
+}
+
+// octalPrefix decodes the one to three octal digits after the backslash
+// at the start of s, returning the value and the escape's length.
+func octalPrefix(s string) (rune, int) {
+	var v rune
+	i := 1
+	for ; i < 4 && i < len(s) && isOctal(s[i]); i++ {
+		v = v<<3 | rune(s[i]-'0')
+	}
+	return v, i
+}
+
+// unhexN decodes exactly n hex digits at the start of s. ok is false if
+// there are fewer or the value is beyond utf8.MaxRune.
+func unhexN(s string, n int) (r rune, ok bool) {
+	if len(s) < n {
+		return 0, false
+	}
+	var v uint32
+	for i := 0; i < n; i++ {
+		d, ok := unhex(s[i])
+		if !ok {
+			return 0, false
+		}
+		v = v<<4 | uint32(d)
+	}
+	if v > utf8.MaxRune {
+		return 0, false
+	}
+	return rune(v), true
+}
+
+// unhex returns the value of the hex digit c.
+func unhex(c byte) (rune, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return rune(c - '0'), true
+	case 'a' <= c && c <= 'f':
+		return rune(c - 'a' + 10), true
+	case 'A' <= c && c <= 'F':
+		return rune(c - 'A' + 10), true
+	}
+	return 0, false
+}
+
+// isOctal reports whether c is an octal digit.
+func isOctal(c byte) bool {
+	return '0' <= c && c <= '7'
+}
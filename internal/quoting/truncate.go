@@ -0,0 +1,160 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quoting
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// Length-bounded literals.
+
+// A Literal selects the syntax of a truncated literal: a double-quoted Go
+// string in one of the quote modes, or a JSON string.
+type Literal struct {
+	Mode int  // Go quote mode
+	JSON bool // write a JSON string instead
+	HTML bool // in JSON, escape <, > and &
+}
+
+// truncMarker returns the length of the marker that ends a literal from
+// which n input bytes were omitted: ...(+n bytes).
+func truncMarker(n int) int {
+	digits := 1
+	for ; n >= 10; n /= 10 {
+		digits++
+	}
+	return len("...(+") + digits + len(" bytes)")
+}
+
+// AppendTruncated appends s to dst as a double-quoted literal of at most
+// maxBytes bytes, which must be at least 2.
+//
+// If the whole literal does not fit, the longest prefix of s that leaves
+// room for the marker ...(+n bytes), n being the number of bytes of s
+// left out, is written followed by the marker. If maxBytes has no room
+// for the count, the marker is only the ellipsis, and if it has none for
+// that either, the literal is empty. Characters and escapes are never
+// split.
+//
+// The prefix is first written with room kept for the longest marker
+// possible, and then tentatively continued to find out whether the rest
+// fits as well. Only if it does not is that tail dropped again and the
+// prefix extended by the few characters the actual marker leaves room
+// for.
+func AppendTruncated(dst []byte, s string, maxBytes int, lit Literal) []byte {
+	budget := maxBytes - 2 // for the content between the quotes
+	dst = append(dst, '"')
+	start := len(dst)
+
+	dst, i := lit.appendPrefix(dst, s, budget-truncMarker(len(s)))
+	if i < len(s) {
+		cut := len(dst)
+		var n int
+		dst, n = lit.appendPrefix(dst, s[i:], budget-(cut-start))
+		if i+n < len(s) {
+			dst = dst[:cut]
+			counted := cut-start+truncMarker(len(s)-i) <= budget
+			for i < len(s) {
+				r, width := utf8.DecodeRuneInString(s[i:])
+				marker := len("...")
+				if counted {
+					marker = truncMarker(len(s) - i - width)
+				}
+				if len(dst)-start+lit.size(r, width)+marker > budget {
+					break
+				}
+				dst = lit.append(dst, r, s[i:i+width])
+				i += width
+			}
+			switch {
+			case counted:
+				dst = append(dst, "...(+"...)
+				dst = strconv.AppendInt(dst, int64(len(s)-i), 10)
+				dst = append(dst, " bytes)"...)
+			case len(dst)-start+len("...") <= budget:
+				dst = append(dst, "..."...)
+			}
+		}
+	}
+	return append(dst, '"')
+}
+
+// appendPrefix appends the longest prefix of s whose escaped form is at
+// most limit bytes long, and returns the number of bytes of s it took.
+func (lit *Literal) appendPrefix(dst []byte, s string, limit int) ([]byte, int) {
+	used, i := 0, 0
+	for i < len(s) && used < limit {
+		// Copy a run of bytes that need no escaping at once. The HTML
+		// characters are not known to the scan.
+		if c := s[i]; c >= ' ' && c < 0x7F && c != '"' && c != '\\' && !(lit.JSON && lit.HTML) {
+			n := IndexEscape(s[i:], '"')
+			if n < 0 {
+				n = len(s) - i
+			}
+			n = min(n, limit-used)
+			dst = append(dst, s[i:i+n]...)
+			used += n
+			i += n
+			if used == limit || i == len(s) {
+				break
+			}
+		}
+		r, width := utf8.DecodeRuneInString(s[i:])
+		size := lit.size(r, width)
+		if used+size > limit {
+			break
+		}
+		dst = lit.append(dst, r, s[i:i+width])
+		used += size
+		i += width
+	}
+	return dst, i
+}
+
+// size returns the length of the escaped form of the character r, which
+// is width bytes long in the input.
+func (lit *Literal) size(r rune, width int) int {
+	switch {
+	case lit.JSON:
+		switch {
+		case r < utf8.RuneSelf && (lit.HTML && jsonHTMLSafe[r] || !lit.HTML && jsonSafe[r]):
+			return 1
+		case r < utf8.RuneSelf && jsonShort[r] != 0:
+			return 2
+		case r < utf8.RuneSelf || r == 0x2028 || r == 0x2029:
+			return 6 // \u00XX or the line separators
+		case r == utf8.RuneError && width == 1:
+			return len(string(utf8.RuneError))
+		}
+		return width
+	case r == utf8.RuneError && width == 1:
+		return 4 // \xHH
+	}
+	return runeEscapedSize(r, goString, lit.Mode)
+}
+
+// append appends the escaped form of the character r, encoded in s.
+func (lit *Literal) append(dst []byte, r rune, s string) []byte {
+	switch {
+	case lit.JSON:
+		switch {
+		case r < utf8.RuneSelf && (lit.HTML && jsonHTMLSafe[r] || !lit.HTML && jsonSafe[r]):
+			return append(dst, byte(r))
+		case r < utf8.RuneSelf:
+			return appendJSONByte(dst, byte(r))
+		case r == 0x2028 || r == 0x2029:
+			return appendJSONU4(dst, r)
+		case r == utf8.RuneError && len(s) == 1:
+			return utf8.AppendRune(dst, utf8.RuneError)
+		}
+		return append(dst, s...)
+	case r == utf8.RuneError && len(s) == 1:
+		return append(dst, '\\', 'x', hexDigit(s[0]>>4), hexDigit(s[0]&0xF))
+	}
+	pos := len(dst)
+	dst = append(dst, make([]byte, runeEscapedSize(r, goString, lit.Mode))...)
+	return dst[:appendEscapedRune(dst, pos, r, goString, lit.Mode)]
+}
@@ -8,15 +8,30 @@
 package main
 
 import (
+	"bytes"
+	"flag"
 	"fmt"
+	"go/format"
+	"io"
 	"math/big"
 	"os"
 )
 
 // Generate power-of-5 tables for the Ryū algorithm
 // These tables are used for fast float-to-decimal conversion
+//
+// With -printf, the power-of-10 tables used by the Ryū printf
+// fixed-precision digit generator are written instead.
+
+var printf = flag.Bool("printf", false, "generate the Ryū printf power-of-10 tables")
 
 func main() {
+	flag.Parse()
+	if *printf {
+		generatePrintfTables("printf_tables.go")
+		return
+	}
+
 	f, err := os.Create("ryu_tables_generated.go")
 	if err != nil {
 		panic(err)
@@ -145,3 +160,166 @@ func generatePow5InvSplit(f *os.File) {
 	fmt.Fprintln(f, "}")
 	fmt.Fprintln(f, "")
 }
+
+// Ryū printf parameters. They must match the constants in printf.go.
+const (
+	pow10AdditionalBits = 120 // extra precision of each 192-bit multiplier
+	pow10IndexBits      = 16  // binary exponents are bucketed in steps of 2^16
+	maxExp2             = 971 // largest e2 of a float64 (1023 - 52)
+	minExp2             = -1074
+)
+
+func generatePrintfTables(name string) {
+	f := new(bytes.Buffer)
+	fmt.Fprintln(f, "// Copyright 2025 Mohammad Shafiee. All rights reserved.")
+	fmt.Fprintln(f, "// Use of this source code is governed by a BSD-style")
+	fmt.Fprintln(f, "// license that can be found in the LICENSE file.")
+	fmt.Fprintln(f, "")
+	fmt.Fprintln(f, "// Code generated by generate_tables.go -printf; DO NOT EDIT.")
+	fmt.Fprintln(f, "")
+	fmt.Fprintln(f, "package ryu")
+	fmt.Fprintln(f, "")
+
+	generatePow10Split(f)
+	generatePow10Split2(f)
+
+	src, err := format.Source(f.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	if err := os.WriteFile(name, src, 0644); err != nil {
+		panic(err)
+	}
+}
+
+// generatePow10Split writes the multipliers for the integer part of
+// m*2^e2. For the exponent bucket idx = ceil(e2/16) and the 9-digit
+// block i, the entry is ceil(2^(16*idx+120) / 10^(9*i)), reduced modulo
+// 10^9 * 2^136 so that it fits in 192 bits without changing the result
+// of mulShiftMod1e9.
+func generatePow10Split(f io.Writer) {
+	one := big.NewInt(1)
+	modulus := new(big.Int).Lsh(big.NewInt(1e9), pow10AdditionalBits+pow10IndexBits)
+	maxIdx := (maxExp2 + pow10IndexBits - 1) / pow10IndexBits
+
+	var offsets []int
+	var entries []string
+	for idx := 0; idx <= maxIdx; idx++ {
+		offsets = append(offsets, len(entries))
+		// Values are below 2^(53+16*idx).
+		limit := new(big.Int).Lsh(one, uint(53+pow10IndexBits*idx))
+		blocks := (len(limit.String()) + 8) / 9
+		num := new(big.Int).Lsh(one, uint(pow10IndexBits*idx+pow10AdditionalBits))
+		for i := 0; i < blocks; i++ {
+			den := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(9*i)), nil)
+			v := ceilDiv(num, den)
+			v.Mod(v, modulus)
+			entries = append(entries, fmt.Sprintf("%s, // idx=%d, i=%d", split192(v), idx, i))
+		}
+	}
+	offsets = append(offsets, len(entries))
+
+	fmt.Fprintln(f, "// pow10Offset[idx] is the index in pow10Split of the first block for")
+	fmt.Fprintln(f, "// the binary exponent bucket idx; pow10Offset[idx+1] ends it.")
+	writeInts(f, "pow10Offset", "uint16", offsets)
+	fmt.Fprintln(f, "// pow10Split holds the 192-bit multipliers for the integer digits,")
+	fmt.Fprintln(f, "// least significant word first.")
+	writeEntries(f, "pow10Split", entries)
+}
+
+// generatePow10Split2 writes the multipliers for the fractional part of
+// m*2^e2 with e2 < 0. For the bucket idx = -e2/16 and the 9-digit block
+// i, the entry is ceil(10^(9*(i+1)) * 2^(120-16*idx)), reduced modulo
+// 10^9 * 2^136. Blocks below minBlock2[idx] are always zero, as are the
+// blocks past the end of the bucket because the binary fraction ends.
+func generatePow10Split2(f io.Writer) {
+	one := big.NewInt(1)
+	ten := big.NewInt(10)
+	modulus := new(big.Int).Lsh(big.NewInt(1e9), pow10AdditionalBits+pow10IndexBits)
+	maxIdx := -minExp2 / pow10IndexBits
+
+	var offsets, minBlocks []int
+	var entries []string
+	for idx := 0; idx <= maxIdx; idx++ {
+		offsets = append(offsets, len(entries))
+		// Fractions are below 2^(53-16*idx), so block i is zero while
+		// 10^(9*(i+1)) * 2^(53-16*idx) <= 1.
+		minBlock := 0
+		for {
+			p := new(big.Int).Exp(ten, big.NewInt(int64(9*(minBlock+1))), nil)
+			if p.Lsh(p, 53).Cmp(new(big.Int).Lsh(one, uint(pow10IndexBits*idx))) > 0 {
+				break
+			}
+			minBlock++
+		}
+		minBlocks = append(minBlocks, minBlock)
+		// A fraction with denominator 2^s has exactly s decimal places.
+		maxBlock := (pow10IndexBits*idx + pow10IndexBits - 1 + 8) / 9
+		for i := minBlock; i < maxBlock; i++ {
+			v := new(big.Int).Exp(ten, big.NewInt(int64(9*(i+1))), nil)
+			if shift := pow10AdditionalBits - pow10IndexBits*idx; shift >= 0 {
+				v.Lsh(v, uint(shift))
+			} else {
+				v = ceilDiv(v, new(big.Int).Lsh(one, uint(-shift)))
+			}
+			v.Mod(v, modulus)
+			entries = append(entries, fmt.Sprintf("%s, // idx=%d, i=%d", split192(v), idx, i))
+		}
+	}
+	offsets = append(offsets, len(entries))
+
+	fmt.Fprintln(f, "// minBlock2[idx] is the first fractional block that can be non-zero")
+	fmt.Fprintln(f, "// for the binary exponent bucket idx.")
+	writeInts(f, "minBlock2", "uint8", minBlocks)
+	fmt.Fprintln(f, "// pow10Offset2[idx] is the index in pow10Split2 of block minBlock2[idx]")
+	fmt.Fprintln(f, "// for the bucket idx; pow10Offset2[idx+1] ends it.")
+	writeInts(f, "pow10Offset2", "uint16", offsets)
+	fmt.Fprintln(f, "// pow10Split2 holds the 192-bit multipliers for the fractional digits,")
+	fmt.Fprintln(f, "// least significant word first.")
+	writeEntries(f, "pow10Split2", entries)
+}
+
+func ceilDiv(num, den *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() > 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+// split192 formats v as three 64-bit words, least significant first.
+func split192(v *big.Int) string {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 64), big.NewInt(1))
+	var w [3]uint64
+	t := new(big.Int).Set(v)
+	for i := range w {
+		w[i] = new(big.Int).And(t, mask).Uint64()
+		t.Rsh(t, 64)
+	}
+	if t.Sign() != 0 {
+		panic("multiplier does not fit in 192 bits")
+	}
+	return fmt.Sprintf("{0x%x, 0x%x, 0x%x}", w[0], w[1], w[2])
+}
+
+func writeInts(f io.Writer, name, typ string, vals []int) {
+	fmt.Fprintf(f, "var %s = [%d]%s{", name, len(vals), typ)
+	for i, v := range vals {
+		if i%16 == 0 {
+			fmt.Fprint(f, "\n\t")
+		} else {
+			fmt.Fprint(f, " ")
+		}
+		fmt.Fprintf(f, "%d,", v)
+	}
+	fmt.Fprintln(f, "\n}")
+	fmt.Fprintln(f, "")
+}
+
+func writeEntries(f io.Writer, name string, entries []string) {
+	fmt.Fprintf(f, "var %s = [%d][3]uint64{\n", name, len(entries))
+	for _, e := range entries {
+		fmt.Fprintf(f, "\t%s\n", e)
+	}
+	fmt.Fprintln(f, "}")
+}
@@ -0,0 +1,233 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ryu
+
+import (
+	"math"
+	"math/bits"
+)
+
+//go:generate go run generate_tables.go -printf
+
+// Ryū printf: fixed-precision float64 to decimal conversion.
+//
+// Reference: "Ryū revisited: printf floating point conversion" by Ulf Adams (2019)
+// https://dl.acm.org/doi/10.1145/3360595
+//
+// The value m*2^e2 is split into 9-digit blocks. Each block is obtained by
+// multiplying m by a 192-bit precomputed approximation of 2^e2/10^(9i)
+// (integer part) or 10^(9(i+1))*2^e2 (fractional part), shifting, and
+// reducing modulo 10^9. The tables are bucketed by the binary exponent in
+// steps of 2^16 and generated by generate_tables.go -printf.
+
+const (
+	POW10_ADDITIONAL_BITS = 120
+	POW10_INDEX_BITS      = 16
+
+	// MaxFixedDigits is the buffer size FixedDigits needs. A float64 has at
+	// most 767 significant decimal digits; the rest covers the digits of the
+	// last block that are emitted before trailing zeros are trimmed.
+	MaxFixedDigits = 800
+)
+
+// FixedDigits writes the decimal digits of m*2^e2 into d, correctly rounded
+// (half to even) to prec significant digits, or to prec digits after the
+// decimal point when fixed is true. m must be below 2^53 and e2 within the
+// float64 range. d must have room for the kept digits: MaxFixedDigits bytes
+// always suffice, as do prec bytes when fixed is false, or prec plus the
+// number of integer digits when fixed is true.
+//
+// The result is 0.d[:nd] * 10^dp with trailing zeros removed; nd is zero
+// when the value rounds to zero.
+func FixedDigits(d []byte, m uint64, e2 int, prec int, fixed bool) (nd, dp int) {
+	if m == 0 {
+		return 0, 0
+	}
+	g := fixedGen{d: d, fixed: fixed, prec: prec, cut: math.MaxInt}
+	if fixed {
+		g.cut = prec
+	}
+
+	m8 := m << 8
+	// Integer part: block i holds the digits of 10^(9i+8) .. 10^(9i).
+	if e2 >= -52 {
+		idx := 0
+		if e2 > 0 {
+			idx = (e2 + POW10_INDEX_BITS - 1) / POW10_INDEX_BITS
+		}
+		j := POW10_INDEX_BITS*idx + POW10_ADDITIONAL_BITS - e2
+		base := int(pow10Offset[idx])
+		for i := int(pow10Offset[idx+1]) - base - 1; i >= 0 && !g.done; i-- {
+			g.block(mulShiftMod1e9(m8, &pow10Split[base+i], j+8), -9*i-8)
+		}
+	}
+
+	// Fractional part: block i holds the digits of 10^-(9i+1) .. 10^-(9i+9).
+	if e2 < 0 && !g.done {
+		idx := -e2 / POW10_INDEX_BITS
+		j := POW10_ADDITIONAL_BITS - e2 - POW10_INDEX_BITS*idx
+		minBlock := int(minBlock2[idx])
+		if g.cut < 9*minBlock {
+			// The rounding digit lies in the leading zeros.
+			g.done = true
+		}
+		base, end := int(pow10Offset2[idx]), int(pow10Offset2[idx+1])
+		for p := base; p < end && !g.done; p++ {
+			g.block(mulShiftMod1e9(m8, &pow10Split2[p], j+8), 9*(minBlock+p-base)+1)
+		}
+	}
+
+	// Digits past the end of the generated blocks are zero.
+	roundUp := g.next > '5'
+	if g.next == '5' {
+		// Exactly halfway if nothing follows the 5: round to even.
+		roundUp = !isIntegerPow10(m, e2, g.cut+1) || g.nd > 0 && (d[g.nd-1]-'0')&1 == 1
+	}
+	if roundUp {
+		i := g.nd - 1
+		for i >= 0 && d[i] == '9' {
+			i--
+		}
+		if i < 0 {
+			// All nines (or nothing kept): the result is a single 1
+			// just above the last kept position.
+			if g.nd == 0 {
+				g.k0 = g.cut + 1
+			}
+			d[0] = '1'
+			g.nd = 1
+			g.k0--
+		} else {
+			d[i]++
+			g.nd = i + 1
+		}
+	}
+	for g.nd > 0 && d[g.nd-1] == '0' {
+		g.nd--
+	}
+	if g.nd == 0 {
+		return 0, 0
+	}
+	return g.nd, 1 - g.k0
+}
+
+// fixedGen collects digits for FixedDigits. Digit positions are counted
+// from the decimal point: position k is the digit of 10^-k.
+type fixedGen struct {
+	d     []byte
+	nd    int  // digits kept so far
+	k0    int  // position of d[0]
+	cut   int  // position of the last digit to keep
+	prec  int  // significant digits wanted, unless fixed
+	fixed bool // prec counts digits after the decimal point
+	done  bool // the rounding digit has been reached
+	next  byte // the rounding digit, at position cut+1
+}
+
+// block consumes the 9 digits of v, the first of which is at position k.
+func (g *fixedGen) block(v uint32, k int) {
+	if k+8 <= g.cut {
+		switch {
+		case g.nd > 0:
+			// The whole block is kept.
+			write9Digits(g.d[g.nd:g.nd+9], v)
+			g.nd += 9
+			return
+		case v == 0:
+			return // leading zeros
+		}
+	}
+	var buf [9]byte
+	write9Digits(buf[:], v)
+	for i, c := range buf {
+		pos := k + i
+		if pos > g.cut {
+			g.next = c
+			g.done = true
+			return
+		}
+		if g.nd == 0 {
+			if c == '0' {
+				continue // leading zero
+			}
+			g.k0 = pos
+			if !g.fixed {
+				g.cut = pos + g.prec - 1
+			}
+		}
+		g.d[g.nd] = c
+		g.nd++
+	}
+}
+
+const digitPairs = "00010203040506070809" +
+	"10111213141516171819" +
+	"20212223242526272829" +
+	"30313233343536373839" +
+	"40414243444546474849" +
+	"50515253545556575859" +
+	"60616263646566676869" +
+	"70717273747576777879" +
+	"80818283848586878889" +
+	"90919293949596979899"
+
+// write9Digits writes v < 10^9 as exactly 9 decimal digits.
+func write9Digits(b []byte, v uint32) {
+	_ = b[8]
+	for i := 7; i > 0; i -= 2 {
+		r := v % 100
+		v /= 100
+		b[i] = digitPairs[2*r]
+		b[i+1] = digitPairs[2*r+1]
+	}
+	b[0] = byte('0' + v)
+}
+
+// mulShiftMod1e9 returns ((m * mul) >> j) mod 10^9 for a 192-bit mul and
+// 128 <= j <= 180.
+func mulShiftMod1e9(m uint64, mul *[3]uint64, j int) uint32 {
+	hi0, _ := bits.Mul64(m, mul[0])
+	hi1, lo1 := bits.Mul64(m, mul[1])
+	hi2, lo2 := bits.Mul64(m, mul[2])
+	_, c1 := bits.Add64(lo1, hi0, 0)
+	s1lo, c2 := bits.Add64(lo2, hi1, c1)
+	s1hi := hi2 + c2
+	dist := uint(j - 128)
+	lo := s1lo>>dist | s1hi<<(64-dist)
+	hi := s1hi >> dist
+	return uint128Mod1e9(hi, lo)
+}
+
+// uint128Mod1e9 returns (hi<<64 | lo) mod 10^9 for values below 2^125,
+// multiplying by ceil(2^157 / 10^9) instead of dividing.
+func uint128Mod1e9(hi, lo uint64) uint32 {
+	const rHi, rLo = 0x89705f4136b4a597, 0x31680a88f8953031
+	b00Hi, _ := bits.Mul64(lo, rLo)
+	b01Hi, b01Lo := bits.Mul64(lo, rHi)
+	b10Hi, b10Lo := bits.Mul64(hi, rLo)
+	_, b11Lo := bits.Mul64(hi, rHi)
+	t1Lo, c := bits.Add64(b10Lo, b00Hi, 0)
+	t1Hi := b10Hi + c
+	_, c = bits.Add64(b01Lo, t1Lo, 0)
+	t2Hi := b01Hi + c
+	q := uint32((b11Lo + t1Hi + t2Hi) >> 29)
+	return uint32(lo) - 1e9*q
+}
+
+// isIntegerPow10 reports whether m * 2^e2 * 10^k is an integer.
+func isIntegerPow10(m uint64, e2, k int) bool {
+	if twos := e2 + k; twos < 0 {
+		if twos <= -64 || m&(1<<uint(-twos)-1) != 0 {
+			return false
+		}
+	}
+	for ; k < 0; k++ {
+		if m%5 != 0 {
+			return false
+		}
+		m /= 5
+	}
+	return true
+}
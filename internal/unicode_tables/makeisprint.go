@@ -0,0 +1,236 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Portions Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// makeisprint generates the IsPrint and IsGraphic tables from a
+// UnicodeData.txt file:
+//
+//	go run makeisprint.go -ucd ../../testdata/UnicodeData.txt -version 17.0.0 -output tables.go
+//
+// Only the code point and General_Category fields are read, so the file
+// may be the one published by the Unicode Consortium, for example
+// https://www.unicode.org/Public/17.0.0/ucd/UnicodeData.txt, or a copy
+// reduced to those fields. -version must name the Unicode version the file
+// was taken from.
+//
+// The copy in testdata is still such a reduced file, written out from the
+// categories of Go 1.27's unicode package (Unicode 17.0.0) with long runs
+// of one category given as First/Last ranges. It is not the official file
+// and should be replaced by it, unchanged, followed by a run of this
+// program; the tests check the tables against whichever file is vendored.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var (
+	ucd      = flag.String("ucd", "../../testdata/UnicodeData.txt", "UnicodeData.txt `file` to read")
+	version  = flag.String("version", "", "Unicode `version` of the data")
+	filename = flag.String("output", "tables.go", "output file name")
+)
+
+const maxRune = 0x10FFFF
+
+// categories holds the General_Category of every code point, empty for
+// unassigned ones.
+var categories [maxRune + 1]string
+
+// load reads the General_Category fields of a UnicodeData.txt file. Large
+// blocks are given as a pair of lines whose names end in ", First>" and
+// ", Last>".
+func load(name string) {
+	f, err := os.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	first := rune(-1)
+	s := bufio.NewScanner(f)
+	for line := 1; s.Scan(); line++ {
+		if s.Text() == "" || strings.HasPrefix(s.Text(), "#") {
+			continue
+		}
+		fields := strings.Split(s.Text(), ";")
+		if len(fields) != 15 {
+			log.Fatalf("%s:%d: %d fields, want 15", name, line, len(fields))
+		}
+		v, err := strconv.ParseUint(fields[0], 16, 32)
+		if err != nil || v > maxRune {
+			log.Fatalf("%s:%d: bad code point %q", name, line, fields[0])
+		}
+		r, gc := rune(v), fields[2]
+		if len(gc) != 2 {
+			log.Fatalf("%s:%d: bad category %q", name, line, gc)
+		}
+		switch {
+		case strings.HasSuffix(fields[1], ", First>"):
+			first = r
+			continue
+		case strings.HasSuffix(fields[1], ", Last>"):
+			if first < 0 || first > r {
+				log.Fatalf("%s:%d: range end without start", name, line)
+			}
+		default:
+			first = r
+		}
+		for ; first <= r; first++ {
+			categories[first] = gc
+		}
+		first = -1
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// isPrint reports whether r is in one of the categories L, M, N, P and S,
+// or is the ASCII space, as [unicode.IsPrint] does.
+func isPrint(r rune) bool {
+	if r == ' ' {
+		return true
+	}
+	gc := categories[r]
+	return gc != "" && strings.Contains("LMNPS", gc[:1])
+}
+
+// isGraphic reports whether r is printable or a space separator, as
+// [unicode.IsGraphic] does.
+func isGraphic(r rune) bool {
+	return isPrint(r) || categories[r] == "Zs"
+}
+
+// scan returns the ranges of printable runes in [min, max] and the
+// unprintable runes within them. A range is only split when there are two
+// unprintable runes in a row, to keep the range list short.
+func scan(min, max rune) (rang, except []uint32) {
+	lo := rune(-1)
+	for i := min; ; i++ {
+		if (i > max || !isPrint(i)) && lo >= 0 {
+			// End range, but avoid flip flop.
+			if i+1 <= max && isPrint(i+1) {
+				except = append(except, uint32(i))
+				continue
+			}
+			rang = append(rang, uint32(lo), uint32(i-1))
+			lo = -1
+		}
+		if i > max {
+			break
+		}
+		if lo < 0 && isPrint(i) {
+			lo = i
+		}
+	}
+	return
+}
+
+func main() {
+	flag.Parse()
+	if *version == "" {
+		log.Fatal("-version is required")
+	}
+	load(*ucd)
+
+	rang, except := scan(0, 0xFFFF)
+	range16 := to16(rang)
+	except16 := to16(except)
+	range32, except32 := scan(0x10000, maxRune)
+
+	for _, r := range except32 {
+		if r >= 0x20000 {
+			log.Fatalf("%U too big for NotPrint32", r)
+		}
+	}
+	var graphic []uint16
+	for r := rune(0); r <= maxRune; r++ {
+		if isGraphic(r) && !isPrint(r) {
+			if r > 0xFFFF {
+				log.Fatalf("%U too big for GraphicList", r)
+			}
+			graphic = append(graphic, uint16(r))
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `// Copyright 2013 The Go Authors. All rights reserved.
+// Portions Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by go run makeisprint.go -version %s -output %s; DO NOT EDIT.
+
+package unicode_tables
+
+// Version is the Unicode version the tables were generated from.
+const Version = %q
+
+`, *version, *filename, *version)
+	fmt.Fprintf(&buf, "// (%d+%d+%d)*2 + (%d)*4 = %d bytes\n\n", len(range16), len(except16), len(except32), len(range32),
+		(len(range16)+len(except16)+len(except32))*2+(len(range32))*4)
+
+	fmt.Fprintf(&buf, "// Print16 holds the bounds of the ranges of printable runes below 0x10000.\n")
+	fmt.Fprintf(&buf, "var Print16 = []uint16{\n")
+	for i := 0; i < len(range16); i += 2 {
+		fmt.Fprintf(&buf, "\t%#04x, %#04x,\n", range16[i], range16[i+1])
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// NotPrint16 lists the runes within Print16 that are not printable.\n")
+	fmt.Fprintf(&buf, "var NotPrint16 = []uint16{\n")
+	for _, r := range except16 {
+		fmt.Fprintf(&buf, "\t%#04x,\n", r)
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// Print32 holds the bounds of the ranges of printable runes from 0x10000.\n")
+	fmt.Fprintf(&buf, "var Print32 = []uint32{\n")
+	for i := 0; i < len(range32); i += 2 {
+		fmt.Fprintf(&buf, "\t%#06x, %#06x,\n", range32[i], range32[i+1])
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// NotPrint32 lists the runes within Print32 that are not printable.\n")
+	fmt.Fprintf(&buf, "var NotPrint32 = []uint16{ // add 0x10000 to each entry\n")
+	for _, r := range except32 {
+		fmt.Fprintf(&buf, "\t%#04x,\n", r-0x10000)
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// GraphicList lists the graphic runes not matched by IsPrint.\n")
+	fmt.Fprintf(&buf, "var GraphicList = []uint16{\n")
+	for _, r := range graphic {
+		fmt.Fprintf(&buf, "\t%#04x,\n", r)
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	data, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*filename, data, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func to16(x []uint32) []uint16 {
+	var y []uint16
+	for _, v := range x {
+		if uint32(uint16(v)) != v {
+			log.Fatalf("%#x does not fit in 16 bits", v)
+		}
+		y = append(y, uint16(v))
+	}
+	return y
+}
@@ -6,7 +6,7 @@
 
 package validation
 
-import "golang.org/x/sys/cpu"
+import "github.com/mshafiee/fastparse/internal/dispatch"
 
 // hasUnderscoreAVX2 scans for underscore using AVX2 (32 bytes at a time)
 //
@@ -18,13 +18,6 @@ func hasUnderscoreAVX2(s string) bool
 //go:noescape
 func hasUnderscoreSSE2(s string) bool
 
-var useAVX2 bool
-
-func init() {
-	// Check for AVX2 support
-	useAVX2 = cpu.X86.HasAVX2
-}
-
 // hasComplexCharsImpl uses SIMD to scan for underscores
 func hasComplexCharsImpl(s string) bool {
 	// For very short strings, use scalar
@@ -32,11 +25,14 @@ func hasComplexCharsImpl(s string) bool {
 		return hasUnderscoreScalar(s)
 	}
 
-	// Use AVX2 if available, otherwise SSE2
-	if useAVX2 {
+	// Use AVX2 if allowed, otherwise SSE2
+	switch {
+	case dispatch.Allow(dispatch.AVX2):
 		return hasUnderscoreAVX2(s)
+	case dispatch.Allow(dispatch.SSE):
+		return hasUnderscoreSSE2(s)
 	}
-	return hasUnderscoreSSE2(s)
+	return hasUnderscoreScalar(s)
 }
 
 // hasUnderscoreScalar is a scalar fallback
@@ -6,6 +6,8 @@
 
 package validation
 
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
 // hasUnderscoreNEON scans for underscore using NEON (16 bytes at a time)
 //
 //go:noescape
@@ -14,11 +16,10 @@ func hasUnderscoreNEON(s string) bool
 // hasComplexCharsImpl uses NEON SIMD to scan for underscores
 func hasComplexCharsImpl(s string) bool {
 	// For very short strings, use scalar
-	if len(s) < 16 {
+	if len(s) < 16 || !dispatch.Allow(dispatch.NEON) {
 		return hasUnderscoreScalar(s)
 	}
 
-	// NEON is always available on ARM64
 	return hasUnderscoreNEON(s)
 }
 
@@ -0,0 +1,358 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"bytes"
+	"errors"
+	"unicode/utf8"
+
+	"github.com/mshafiee/fastparse/internal/quoting"
+)
+
+// These are the errors a LogfmtDecoder or LogfmtEncoder reports, inside a
+// [LogfmtError], for malformed pairs and keys that cannot be written.
+var (
+	ErrLogfmtKey   = errors.New("invalid key")
+	ErrLogfmtValue = errors.New("unexpected '=' or '\"' in unquoted value")
+	ErrLogfmtQuote = errors.New("unterminated or malformed quoted value")
+)
+
+// A LogfmtError reports a malformed logfmt pair, a value that a typed
+// accessor of [LogfmtDecoder] could not convert, or a key that a
+// [LogfmtEncoder] could not write.
+type LogfmtError struct {
+	Record int    // record number, starting at 1
+	Offset int    // byte offset in the input, or output, where the error is
+	Key    string // the key of the pair, if it was read
+	Err    error  // the underlying error, such as ErrLogfmtQuote or a *NumError
+}
+
+func (e *LogfmtError) Error() string {
+	s := "fastparse: logfmt: record " + Itoa(e.Record) + " (offset " + Itoa(e.Offset) + "): "
+	if e.Key != "" {
+		s += "key " + QuoteToASCII(e.Key) + ": "
+	}
+	return s + e.Err.Error()
+}
+
+func (e *LogfmtError) Unwrap() error { return e.Err }
+
+// A LogfmtDecoder reads logfmt records, one per line, from a byte slice.
+// Each record is a sequence of key=value pairs separated by spaces. A
+// value is either bare, running to the next space, or quoted with Go's
+// escape sequences, which cover those logfmt encoders write. A key may
+// also appear alone, without a value.
+//
+//	d := fastparse.NewLogfmtDecoder(data)
+//	for d.ScanRecord() {
+//		for d.ScanKeyval() {
+//			use(d.Key(), d.Value())
+//		}
+//		if err := d.Err(); err != nil {
+//			// The rest of the record is skipped.
+//		}
+//	}
+//
+// Keys and values are views of the input, except for quoted values with
+// escape sequences, which are unquoted into a buffer the decoder reuses;
+// decoding does not allocate once that buffer has grown to fit. The typed
+// accessors convert the current value without copying it.
+type LogfmtDecoder struct {
+	data   []byte
+	pos    int // start of the unread input
+	end    int // end of the current record
+	record int // number of the current record
+	start  int // offset of the current pair
+	key    []byte
+	value  []byte
+	buf    []byte // unquoted values with escape sequences
+	err    error
+}
+
+// NewLogfmtDecoder returns a LogfmtDecoder reading from data.
+func NewLogfmtDecoder(data []byte) *LogfmtDecoder {
+	return &LogfmtDecoder{data: data}
+}
+
+// Reset makes d read from data, keeping its buffer for reuse.
+func (d *LogfmtDecoder) Reset(data []byte) {
+	*d = LogfmtDecoder{data: data, buf: d.buf[:0]}
+}
+
+// ScanRecord advances to the next record, the next line of the input,
+// and reports whether there is one. A record is read with ScanKeyval;
+// whatever of it was not read is skipped.
+func (d *LogfmtDecoder) ScanRecord() bool {
+	if d.record > 0 {
+		d.pos = d.end + 1
+	}
+	d.key, d.value, d.err = nil, nil, nil
+	if d.pos >= len(d.data) {
+		d.end = len(d.data)
+		return false
+	}
+	d.end = len(d.data)
+	if i := bytes.IndexByte(d.data[d.pos:], '\n'); i >= 0 {
+		d.end = d.pos + i
+	}
+	d.record++
+	return true
+}
+
+// ScanKeyval advances to the next pair of the current record and reports
+// whether there is one. It returns false at the end of the record, and on
+// a malformed pair, which Err then reports as a *[LogfmtError]; after
+// that, ScanKeyval returns false until ScanRecord moves on.
+func (d *LogfmtDecoder) ScanKeyval() bool {
+	d.key, d.value = nil, nil
+	if d.err != nil {
+		return false
+	}
+	line := d.data[:d.end]
+	i := d.pos
+	for i < len(line) && line[i] <= ' ' {
+		i++
+	}
+	d.pos = i
+	if i == len(line) {
+		return false
+	}
+	d.start = i
+
+	j := i
+	for j < len(line) && line[j] > ' ' && line[j] != '=' && line[j] != '"' {
+		j++
+	}
+	if j == i || j < len(line) && line[j] == '"' {
+		return d.fail(j, ErrLogfmtKey)
+	}
+	d.key = line[i:j]
+	if j == len(line) || line[j] != '=' {
+		d.pos = j
+		return true
+	}
+
+	j++
+	switch {
+	case j == len(line) || line[j] <= ' ':
+		d.value = line[j:j]
+	case line[j] == '"':
+		v, next, ok := d.unquote(j)
+		if !ok || next < len(line) && line[next] > ' ' {
+			return d.fail(j, ErrLogfmtQuote)
+		}
+		d.value, j = v, next
+	default:
+		k := j
+		for ; k < len(line) && line[k] > ' '; k++ {
+			if line[k] == '=' || line[k] == '"' {
+				return d.fail(k, ErrLogfmtValue)
+			}
+		}
+		d.value, j = line[j:k], k
+	}
+	d.pos = j
+	return true
+}
+
+// unquote returns the value of the quoted value starting at d.data[q],
+// and the offset just past it. Runs without escape sequences are found
+// with SIMD; each escape sequence is decoded by UnquoteChar.
+func (d *LogfmtDecoder) unquote(q int) (value []byte, next int, ok bool) {
+	s := bytesToString(d.data[q+1 : d.end])
+	i := quoting.UnquoteSpecial.Index(s)
+	if i < 0 {
+		return nil, 0, false
+	}
+	if s[i] == '"' {
+		// No escape sequences: the value is a view of the input.
+		return d.data[q+1 : q+1+i], q + 2 + i, true
+	}
+	d.buf = append(d.buf[:0], s[:i]...)
+	s = s[i:]
+	for s[0] != '"' {
+		r, multibyte, rem, err := UnquoteChar(s, '"')
+		if err != nil {
+			return nil, 0, false
+		}
+		if r < utf8.RuneSelf || !multibyte {
+			d.buf = append(d.buf, byte(r))
+		} else {
+			d.buf = utf8.AppendRune(d.buf, r)
+		}
+		s = rem
+		i = quoting.UnquoteSpecial.Index(s)
+		if i < 0 {
+			return nil, 0, false
+		}
+		d.buf = append(d.buf, s[:i]...)
+		s = s[i:]
+	}
+	return d.buf, d.end - len(s) + 1, true
+}
+
+// fail records a malformed pair at offset at and ends the record.
+func (d *LogfmtDecoder) fail(at int, err error) bool {
+	d.err = &LogfmtError{Record: d.record, Offset: at, Key: string(d.key), Err: err}
+	d.key, d.value = nil, nil
+	d.pos = d.end
+	return false
+}
+
+// Err returns the error that ended the current record early, or nil.
+func (d *LogfmtDecoder) Err() error {
+	return d.err
+}
+
+// Key returns the key of the current pair. The slice is only valid until
+// the next call to ScanKeyval or ScanRecord.
+func (d *LogfmtDecoder) Key() []byte {
+	return d.key
+}
+
+// Value returns the unquoted value of the current pair, or nil if the key
+// has no value. Like Key's, the slice is only valid until the next call
+// to ScanKeyval or ScanRecord.
+func (d *LogfmtDecoder) Value() []byte {
+	return d.value
+}
+
+// valueError wraps err, returned for the current value, in a *LogfmtError.
+func (d *LogfmtDecoder) valueError(err error) error {
+	return &LogfmtError{Record: d.record, Offset: d.start, Key: string(d.key), Err: err}
+}
+
+// Int64 returns the current value parsed by [ParseInt] in base 10.
+func (d *LogfmtDecoder) Int64() (int64, error) {
+	v, err := ParseInt(bytesToString(d.value), 10, 64)
+	if err != nil {
+		return v, d.valueError(err)
+	}
+	return v, nil
+}
+
+// Uint64 returns the current value parsed by [ParseUint] in base 10.
+func (d *LogfmtDecoder) Uint64() (uint64, error) {
+	v, err := ParseUint(bytesToString(d.value), 10, 64)
+	if err != nil {
+		return v, d.valueError(err)
+	}
+	return v, nil
+}
+
+// Float64 returns the current value parsed by [ParseFloat].
+func (d *LogfmtDecoder) Float64() (float64, error) {
+	v, err := ParseFloat(bytesToString(d.value), 64)
+	if err != nil {
+		return v, d.valueError(err)
+	}
+	return v, nil
+}
+
+// Bool returns the current value parsed by [ParseBool].
+func (d *LogfmtDecoder) Bool() (bool, error) {
+	v, err := ParseBool(bytesToString(d.value))
+	if err != nil {
+		return v, d.valueError(err)
+	}
+	return v, nil
+}
+
+// A LogfmtEncoder appends logfmt records to a byte slice. Values are
+// written bare unless they contain a space, a control character, '=',
+// '"' or invalid UTF-8, and then quoted with the escape sequences
+// LogfmtDecoder reads, found with SIMD. Numbers are formatted by
+// [AppendInt], [AppendUint] and [AppendFloat].
+//
+// A key must be nonempty valid UTF-8 without spaces, control characters,
+// '=' or '"'. A pair with any other key is dropped, and the first such
+// key is reported by Err.
+type LogfmtEncoder struct {
+	buf    []byte
+	start  int // start of the current record in buf
+	record int // number of records ended
+	err    error
+}
+
+// NewLogfmtEncoder returns a LogfmtEncoder appending to buf.
+func NewLogfmtEncoder(buf []byte) *LogfmtEncoder {
+	return &LogfmtEncoder{buf: buf, start: len(buf)}
+}
+
+// key appends k and '=' to the current record, after a space if the
+// record has pairs already, and reports whether k is a valid key.
+func (e *LogfmtEncoder) key(k string) bool {
+	if !quoting.ValidLogfmtKey(k) {
+		if e.err == nil {
+			e.err = &LogfmtError{Record: e.record + 1, Offset: len(e.buf), Key: k, Err: ErrLogfmtKey}
+		}
+		return false
+	}
+	if len(e.buf) > e.start {
+		e.buf = append(e.buf, ' ')
+	}
+	e.buf = append(e.buf, k...)
+	e.buf = append(e.buf, '=')
+	return true
+}
+
+// AddString appends the pair key=value, quoting value if needed.
+func (e *LogfmtEncoder) AddString(key, value string) {
+	if e.key(key) {
+		e.buf = quoting.AppendLogfmt(e.buf, value)
+	}
+}
+
+// AddInt appends the pair key=v, with v in base 10.
+func (e *LogfmtEncoder) AddInt(key string, v int64) {
+	if e.key(key) {
+		e.buf = AppendInt(e.buf, v, 10)
+	}
+}
+
+// AddUint appends the pair key=v, with v in base 10.
+func (e *LogfmtEncoder) AddUint(key string, v uint64) {
+	if e.key(key) {
+		e.buf = AppendUint(e.buf, v, 10)
+	}
+}
+
+// AddFloat appends the pair key=f, with f in the shortest 'g' format.
+func (e *LogfmtEncoder) AddFloat(key string, f float64) {
+	if e.key(key) {
+		e.buf = AppendFloat(e.buf, f, 'g', -1, 64)
+	}
+}
+
+// AddBool appends the pair key=true or key=false.
+func (e *LogfmtEncoder) AddBool(key string, v bool) {
+	if e.key(key) {
+		e.buf = AppendBool(e.buf, v)
+	}
+}
+
+// EndRecord ends the current record with a newline.
+func (e *LogfmtEncoder) EndRecord() {
+	e.buf = append(e.buf, '\n')
+	e.start = len(e.buf)
+	e.record++
+}
+
+// Bytes returns the encoded records.
+func (e *LogfmtEncoder) Bytes() []byte {
+	return e.buf
+}
+
+// Err returns the error for the first pair dropped for an invalid key, or
+// nil.
+func (e *LogfmtEncoder) Err() error {
+	return e.err
+}
+
+// Reset empties e, keeping its buffer for reuse, and clears its error.
+func (e *LogfmtEncoder) Reset() {
+	*e = LogfmtEncoder{buf: e.buf[:0]}
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// logfmtPairs decodes data into "key=value" strings, one slice per
+// record, with "key" alone for a key without a value and "!" plus the
+// error text for a malformed pair.
+func logfmtPairs(data string) [][]string {
+	var records [][]string
+	d := NewLogfmtDecoder([]byte(data))
+	for d.ScanRecord() {
+		pairs := []string{}
+		for d.ScanKeyval() {
+			p := string(d.Key())
+			if d.Value() != nil {
+				p += "=" + string(d.Value())
+			}
+			pairs = append(pairs, p)
+		}
+		if err := d.Err(); err != nil {
+			pairs = append(pairs, "!"+err.Error())
+		}
+		records = append(records, pairs)
+	}
+	return records
+}
+
+func TestLogfmtDecoder(t *testing.T) {
+	tests := []struct {
+		in   string
+		want [][]string
+	}{
+		{"", nil},
+		{"a=1 b=two", [][]string{{"a=1", "b=two"}}},
+		{"a=1\nb=2\n", [][]string{{"a=1"}, {"b=2"}}},
+		{"\n\r\na=1\r\n", [][]string{{}, {}, {"a=1"}}},
+		{`  msg="hello world"  flag  empty= quoted=""`, [][]string{{"msg=hello world", "flag", "empty=", "quoted="}}},
+		{`esc="a\"b\\c\nd\te\u00e9\x41"`, [][]string{{"esc=a\"b\\c\nd\te\u00e9A"}}},
+		{`path=C:\dir url=http://x/a?b&c`, [][]string{{`path=C:\dir`, "url=http://x/a?b&c"}}},
+		{"k=caf\u00e9 \u00fcber=ja", [][]string{{"k=caf\u00e9", "\u00fcber=ja"}}},
+		{`a=1 "b"=2`, [][]string{{"a=1", `!fastparse: logfmt: record 1 (offset 4): invalid key`}}},
+		{`=1`, [][]string{{`!fastparse: logfmt: record 1 (offset 0): invalid key`}}},
+		{`a=b"c d=1`, [][]string{{`!fastparse: logfmt: record 1 (offset 3): key "a": unexpected '=' or '"' in unquoted value`}}},
+		{`a=b=c`, [][]string{{`!fastparse: logfmt: record 1 (offset 3): key "a": unexpected '=' or '"' in unquoted value`}}},
+		{"a=\"open\nb=2", [][]string{{`!fastparse: logfmt: record 1 (offset 2): key "a": unterminated or malformed quoted value`}, {"b=2"}}},
+		{`a="x"y`, [][]string{{`!fastparse: logfmt: record 1 (offset 2): key "a": unterminated or malformed quoted value`}}},
+		{`a="bad \q" b=1`, [][]string{{`!fastparse: logfmt: record 1 (offset 2): key "a": unterminated or malformed quoted value`}}},
+	}
+	for _, test := range tests {
+		got := logfmtPairs(test.in)
+		if !equalRecords(got, test.want) {
+			t.Errorf("decode %q:\n got %q\nwant %q", test.in, got, test.want)
+		}
+	}
+}
+
+func equalRecords(a, b [][]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if strings.Join(a[i], "\x00") != strings.Join(b[i], "\x00") || len(a[i]) != len(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestLogfmtDecoderAccessors(t *testing.T) {
+	d := NewLogfmtDecoder([]byte(`n=-42 u=18446744073709551615 f=2.5e-3 ok=true bad=1x`))
+	d.ScanRecord()
+	var n int64
+	var u uint64
+	var f float64
+	var ok bool
+	var err error
+	for d.ScanKeyval() {
+		switch string(d.Key()) {
+		case "n":
+			n, err = d.Int64()
+		case "u":
+			u, err = d.Uint64()
+		case "f":
+			f, err = d.Float64()
+		case "ok":
+			ok, err = d.Bool()
+		case "bad":
+			_, err = d.Int64()
+			var lerr *LogfmtError
+			var nerr *NumError
+			if !errors.As(err, &lerr) || lerr.Key != "bad" || lerr.Offset != 46 || !errors.As(err, &nerr) || nerr.Num != "1x" {
+				t.Errorf("Int64 of bad: err = %v", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", d.Key(), err)
+		}
+	}
+	if n != -42 || u != 1<<64-1 || f != 2.5e-3 || !ok {
+		t.Errorf("accessors gave %d %d %v %t", n, u, f, ok)
+	}
+}
+
+func TestLogfmtEncoder(t *testing.T) {
+	e := NewLogfmtEncoder(nil)
+	e.AddString("msg", "hello world")
+	e.AddString("path", `C:\dir`)
+	e.AddString("empty", "")
+	e.AddString("q", `say "hi"`)
+	e.AddString("ctl", "a\tb\nc\x01\x7f")
+	e.AddString("eq", "a=b")
+	e.AddString("bad", "x\xffy")
+	e.AddString("utf8", "caf\u00e9")
+	e.AddInt("n", -7)
+	e.AddUint("u", 7)
+	e.AddFloat("f", 0.1)
+	e.AddBool("ok", false)
+	e.EndRecord()
+	e.AddString("has space", "dropped")
+	e.AddInt("next", 1)
+	e.EndRecord()
+	want := `msg="hello world" path=C:\dir empty= q="say \"hi\"" ctl="a\tb\nc\u0001` + "\x7f" + `" eq="a=b" bad="x\ufffdy" utf8=caf` + "\u00e9" + ` n=-7 u=7 f=0.1 ok=false` + "\nnext=1\n"
+	if got := string(e.Bytes()); got != want {
+		t.Errorf("encoded\n%s\nwant\n%s", got, want)
+	}
+	var lerr *LogfmtError
+	if err := e.Err(); !errors.As(err, &lerr) || lerr.Record != 2 || lerr.Key != "has space" || !errors.Is(err, ErrLogfmtKey) {
+		t.Errorf("Err() = %v", err)
+	}
+	e.Reset()
+	for _, key := range []string{"", "a=b", `"a"`, "a\x00", "\xff"} {
+		e.AddInt(key, 1)
+	}
+	if len(e.Bytes()) != 0 {
+		t.Errorf("invalid keys encoded as %q", e.Bytes())
+	}
+}
+
+func TestLogfmtRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	alphabet := []string{"a", "Z", "0", " ", "=", `"`, `\`, "\t", "\n", "\r", "\x00", "\x7f", "\u00e9", "\U0001F680", "\xff", "\xe2\x82"}
+	e := NewLogfmtEncoder(nil)
+	var values []string
+	for i := 0; i < 2000; i++ {
+		var b strings.Builder
+		for n := r.Intn(8); n > 0; n-- {
+			b.WriteString(alphabet[r.Intn(len(alphabet))])
+		}
+		values = append(values, b.String())
+		e.AddString("k", b.String())
+		if i%10 == 9 {
+			e.EndRecord()
+		}
+	}
+	d := NewLogfmtDecoder(e.Bytes())
+	i := 0
+	for d.ScanRecord() {
+		for d.ScanKeyval() {
+			// Each invalid UTF-8 byte reads back as U+FFFD.
+			want := string([]rune(values[i]))
+			if string(d.Key()) != "k" || string(d.Value()) != want {
+				t.Fatalf("pair %d: decoded %q=%q, want k=%q", i, d.Key(), d.Value(), want)
+			}
+			i++
+		}
+		if d.Err() != nil {
+			t.Fatal(d.Err())
+		}
+	}
+	if i != len(values) {
+		t.Errorf("decoded %d pairs, want %d", i, len(values))
+	}
+}
+
+func TestLogfmtAllocs(t *testing.T) {
+	line := []byte(`ts=2025-01-02T03:04:05Z level=info msg="request \"done\"" dur=1.25 status=200 ok=true`)
+	d := NewLogfmtDecoder(line)
+	e := NewLogfmtEncoder(make([]byte, 0, 256))
+	allocs := testing.AllocsPerRun(100, func() {
+		d.Reset(line)
+		for d.ScanRecord() {
+			for d.ScanKeyval() {
+				switch string(d.Key()) {
+				case "dur":
+					d.Float64()
+				case "status":
+					d.Int64()
+				}
+			}
+		}
+		e.Reset()
+		e.AddString("msg", `request "done"`)
+		e.AddFloat("dur", 1.25)
+		e.AddInt("status", 200)
+		e.EndRecord()
+	})
+	if allocs != 0 {
+		t.Errorf("logfmt decoding and encoding: %v allocations, want 0", allocs)
+	}
+}
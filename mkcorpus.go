@@ -0,0 +1,834 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// mkcorpus generates the hard-case float corpus in testdata/fpcorpus:
+//
+//	go run mkcorpus.go -version v1
+//
+// It writes three files into testdata/fpcorpus/<version>: atof.txt, read by
+// the ParseFloat tests, ftoa.txt, read by the AppendFloat tests, and
+// complex.txt, read by the ParseComplex tests. The cases come in families:
+//
+//   - powers of 10 and powers of 2 across the whole range of each size;
+//   - %.20g, shortest and fixed-precision renderings of those;
+//   - random decimal sources and random float targets;
+//   - random targets plus and minus half an ULP, and exactly halfway;
+//   - Hanson-style cases: halfway points written out to all their digits,
+//     or cut short and nudged by one in the last place, which only the
+//     full-precision slow paths get right;
+//   - Paxson-style cases, after Vern Paxson, "A Program for Testing IEEE
+//     Decimal-Binary Conversion": decimals of 16 to 19 digits that are
+//     nearer to a halfway point between two floats than almost any other,
+//     and floats nearer to a halfway point between two decimals of 15 to
+//     17 digits, found by solving for the multiplier that brings
+//     d*10^e/2^q closest to one half.
+//
+// Every expected result comes from a math/big oracle, not from strconv: a
+// ParseFloat case is the input as an exact rational rounded to the
+// nearest float, and a FormatFloat case is checked digit by digit against
+// the exact value of the float rounded half to even. strconv must agree
+// with the oracle on every case, or mkcorpus fails.
+//
+// The output depends only on the flags, so regenerating a version
+// reproduces it. The corpus is versioned so that the tests keep reading a
+// known set of cases: changes to the families belong in a new version,
+// with floatCorpusVersion in corpus_test.go moved to it.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	version = flag.String("version", "v1", "corpus `version`, the name of the directory written")
+	dir     = flag.String("dir", "testdata/fpcorpus", "parent `directory` of the corpus")
+	seed    = flag.Int64("seed", 1, "seed for the random families")
+	count   = flag.Int("n", 100, "cases per random family and size")
+)
+
+// A floatType describes a binary floating-point format.
+type floatType struct {
+	name string
+	bits int
+	prec int // significand bits, including the implicit one
+	emin int // exponent of the smallest subnormal
+	emax int // exponent of the largest binade
+}
+
+var (
+	float64Type = &floatType{"float64", 64, 53, -1074, 1023}
+	float32Type = &floatType{"float32", 32, 24, -149, 127}
+)
+
+var rng *rand.Rand
+
+func main() {
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("mkcorpus: ")
+	rng = rand.New(rand.NewSource(*seed))
+
+	var atof, ftoa []string
+	for _, t := range []*floatType{float64Type, float32Type} {
+		atof = append(atof, atofCases(t)...)
+		ftoa = append(ftoa, ftoaCases(t)...)
+	}
+	cplx := complexCases(atof)
+
+	out := filepath.Join(*dir, *version)
+	if err := os.MkdirAll(out, 0755); err != nil {
+		log.Fatal(err)
+	}
+	write(filepath.Join(out, "atof.txt"), "ParseFloat cases.", []string{
+		"Each case is: type input want, where want is input rounded to the",
+		"nearest value of type, as a hexadecimal float, or ±Inf if it overflows.",
+	}, atof)
+	write(filepath.Join(out, "ftoa.txt"), "FormatFloat and AppendFloat cases.", []string{
+		"Each case is: type value fmt prec want, where value is a hexadecimal",
+		"float and want is FormatFloat(value, fmt, prec, bitSize of type).",
+	}, ftoa)
+	write(filepath.Join(out, "complex.txt"), "ParseComplex cases.", []string{
+		"Each case is: bitSize input real imag, where real and imag are the",
+		"parts ParseComplex(input, bitSize) returns, as hexadecimal floats.",
+	}, cplx)
+}
+
+// write writes the lines of a corpus file under a header. Lines starting
+// with "#" begin a family.
+func write(name, what string, doc, lines []string) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# fastparse float corpus %s: %s\n", *version, what)
+	fmt.Fprintf(&b, "# Code generated by go run mkcorpus.go -version %s -seed %d -n %d; DO NOT EDIT.\n", *version, *seed, *count)
+	for _, l := range doc {
+		fmt.Fprintf(&b, "# %s\n", l)
+	}
+	for _, l := range lines {
+		if strings.HasPrefix(l, "#") {
+			b.WriteString("\n")
+		}
+		b.WriteString(l)
+		b.WriteString("\n")
+	}
+	if err := os.WriteFile(name, []byte(b.String()), 0644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote %s", name)
+}
+
+// hex renders f exactly.
+func hex(f float64, t *floatType) string {
+	return strconv.FormatFloat(f, 'x', -1, t.bits)
+}
+
+// Oracle.
+
+// pow returns b**e as a rational, for any integer e.
+func pow(b int64, e int) *big.Rat {
+	n := new(big.Int).Exp(big.NewInt(b), big.NewInt(int64(abs(e))), nil)
+	if e < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), n)
+	}
+	return new(big.Rat).SetInt(n)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// nearest rounds x to the nearest value of type t, ties to even.
+func nearest(x *big.Rat, t *floatType) float64 {
+	if t.bits == 32 {
+		f, _ := x.Float32()
+		return float64(f)
+	}
+	f, _ := x.Float64()
+	return f
+}
+
+// oracleParse returns the value of the decimal or hexadecimal literal s
+// rounded to type t.
+func oracleParse(s string, t *floatType) float64 {
+	neg := strings.HasPrefix(s, "-")
+	r, ok := new(big.Rat).SetString(strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+"))
+	if !ok {
+		log.Fatalf("oracle: cannot parse %q", s)
+	}
+	f := nearest(r, t)
+	if neg {
+		f = -f
+	}
+	return f
+}
+
+// exact returns the exact value of |f|.
+func exact(f float64) *big.Rat {
+	return new(big.Rat).SetFloat64(math.Abs(f))
+}
+
+// decExp returns the exponent of the leading decimal digit of x > 0.
+func decExp(x *big.Rat) int {
+	fx, _ := x.Float64()
+	e := 0
+	if fx > 0 && !math.IsInf(fx, 0) {
+		e = int(math.Floor(math.Log10(fx)))
+	} else {
+		// Beyond the float64 range: estimate from the sizes of the parts.
+		e = int(float64(x.Num().BitLen()-x.Denom().BitLen()) * math.Log10(2))
+	}
+	for x.Cmp(pow(10, e)) < 0 {
+		e--
+	}
+	for x.Cmp(pow(10, e+1)) >= 0 {
+		e++
+	}
+	return e
+}
+
+// roundHalfEven rounds x >= 0 to an integer, ties to even.
+func roundHalfEven(x *big.Rat) *big.Int {
+	q, r := new(big.Int).QuoRem(x.Num(), x.Denom(), new(big.Int))
+	switch r.Lsh(r, 1).Cmp(x.Denom()) {
+	case 1:
+		q.Add(q, big.NewInt(1))
+	case 0:
+		if q.Bit(0) == 1 {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// roundDigits rounds x > 0 to n significant digits, ties to even, and
+// returns the value and the exponent of its leading digit.
+func roundDigits(x *big.Rat, n int) (*big.Rat, int) {
+	e := decExp(x)
+	q := roundHalfEven(new(big.Rat).Mul(x, pow(10, n-1-e)))
+	v := new(big.Rat).Mul(new(big.Rat).SetInt(q), pow(10, e-n+1))
+	if q.Cmp(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)) == 0 {
+		e++ // rounded up to the next power of ten
+	}
+	return v, e
+}
+
+// bracket returns the n-digit decimals just below and just above x > 0.
+func bracket(x *big.Rat, n int) (lo, hi *big.Rat) {
+	e := decExp(x)
+	scaled := new(big.Rat).Mul(x, pow(10, n-1-e))
+	q, r := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	unit := pow(10, e-n+1)
+	lo = new(big.Rat).Mul(new(big.Rat).SetInt(q), unit)
+	if r.Sign() == 0 {
+		return lo, lo
+	}
+	hi = new(big.Rat).Mul(new(big.Rat).SetInt(q.Add(q, big.NewInt(1))), unit)
+	return lo, hi
+}
+
+// parsedOutput is a formatted float taken apart.
+type parsedOutput struct {
+	neg    bool
+	value  *big.Rat
+	digits string // significant digits of the mantissa
+	frac   int    // digits after the point
+	expo   bool   // has an exponent
+}
+
+func parseOutput(s string) parsedOutput {
+	var p parsedOutput
+	p.neg = strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	mant := s
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mant, p.expo = s[:i], true
+		exp := s[i+1:]
+		if len(exp) < 3 || (exp[0] != '+' && exp[0] != '-') {
+			log.Fatalf("oracle: bad exponent in %q", s)
+		}
+	}
+	if i := strings.IndexByte(mant, '.'); i >= 0 {
+		p.frac = len(mant) - i - 1
+	}
+	p.digits = strings.TrimLeft(strings.Replace(mant, ".", "", 1), "0")
+	v, ok := new(big.Rat).SetString(s)
+	if !ok {
+		log.Fatalf("oracle: cannot read output %q", s)
+	}
+	p.value = v
+	return p
+}
+
+// checkFormat verifies out = FormatFloat(f, fmtc, prec, t.bits) against the
+// exact value of f.
+func checkFormat(f float64, t *floatType, fmtc byte, prec int, out string) error {
+	p := parseOutput(out)
+	if p.neg != math.Signbit(f) {
+		return fmt.Errorf("wrong sign")
+	}
+	x := exact(f)
+	if x.Sign() == 0 {
+		if p.value.Sign() != 0 {
+			return fmt.Errorf("zero formatted as nonzero")
+		}
+		return nil
+	}
+	if prec < 0 {
+		return checkShortest(f, t, fmtc, x, p)
+	}
+	switch fmtc {
+	case 'e':
+		want, _ := roundDigits(x, prec+1)
+		if !p.expo || p.frac != prec || p.value.Cmp(want) != 0 {
+			return fmt.Errorf("want %s to %d digits", want.FloatString(prec+30), prec+1)
+		}
+	case 'f':
+		want := new(big.Rat).SetFrac(roundHalfEven(new(big.Rat).Mul(x, pow(10, prec))), pow(10, prec).Num())
+		if p.expo || p.frac != prec || p.value.Cmp(want) != 0 {
+			return fmt.Errorf("want %s", want.FloatString(prec))
+		}
+	case 'g':
+		if prec == 0 {
+			prec = 1
+		}
+		want, e := roundDigits(x, prec)
+		if p.value.Cmp(want) != 0 || strings.HasSuffix(strings.SplitN(out, "e", 2)[0], "0") && p.frac > 0 {
+			return fmt.Errorf("want %s to %d digits", want.FloatString(prec+30), prec)
+		}
+		nd := len(strings.TrimRight(p.digits, "0"))
+		eprec := prec
+		if eprec > nd && nd >= e+1 {
+			eprec = nd
+		}
+		if p.expo != (e < -4 || e >= eprec) {
+			return fmt.Errorf("wrong notation")
+		}
+	default:
+		return fmt.Errorf("unchecked format %c", fmtc)
+	}
+	return nil
+}
+
+// checkShortest verifies that a shortest formatting reads back as f, that
+// no decimal with fewer digits does, and that no other decimal with as
+// many digits that reads back as f is nearer to it.
+func checkShortest(f float64, t *floatType, fmtc byte, x *big.Rat, p parsedOutput) error {
+	roundTrips := func(v *big.Rat) bool { return nearest(v, t) == math.Abs(f) }
+	n := len(strings.TrimRight(p.digits, "0"))
+	if n != len(p.digits) && p.frac > 0 {
+		return fmt.Errorf("trailing zeros")
+	}
+	if !roundTrips(p.value) {
+		return fmt.Errorf("does not read back")
+	}
+	if n > 1 {
+		lo, hi := bracket(x, n-1)
+		if roundTrips(lo) || roundTrips(hi) {
+			return fmt.Errorf("not shortest")
+		}
+	}
+	dist := func(v *big.Rat) *big.Rat { d := new(big.Rat).Sub(v, x); return d.Abs(d) }
+	lo, hi := bracket(x, n)
+	for _, c := range []*big.Rat{lo, hi} {
+		if roundTrips(c) && dist(c).Cmp(dist(p.value)) < 0 {
+			return fmt.Errorf("%s is nearer", c.FloatString(n+30))
+		}
+	}
+	if e := decExp(p.value); fmtc == 'g' && p.expo != (e < -4 || e >= 6) {
+		return fmt.Errorf("wrong notation")
+	}
+	return nil
+}
+
+// Paxson search.
+
+// firstInRange returns the least x >= 0 with l <= a*x mod m <= r, or nil
+// if there is none. It requires 0 <= l <= r < m.
+func firstInRange(a, m, l, r *big.Int) *big.Int {
+	if l.Sign() == 0 {
+		return new(big.Int)
+	}
+	a = new(big.Int).Mod(a, m)
+	if a.Sign() == 0 {
+		return nil
+	}
+	// The least multiple of a at or above l, if it is within r.
+	k := new(big.Int).Add(l, a)
+	k.Sub(k, big.NewInt(1)).Quo(k, a)
+	if new(big.Int).Mul(a, k).Cmp(r) <= 0 {
+		return k
+	}
+	// Otherwise a*x - m*y lands in [l, r] for the least y with m*y mod a
+	// in [-r mod a, -l mod a], which is the same problem, smaller.
+	nl := new(big.Int).Neg(r)
+	nl.Mod(nl, a)
+	nr := new(big.Int).Neg(l)
+	nr.Mod(nr, a)
+	y := firstInRange(m, a, nl, nr)
+	if y == nil {
+		return nil
+	}
+	x := new(big.Int).Mul(m, y)
+	x.Add(x, l).Add(x, a).Sub(x, big.NewInt(1)).Quo(x, a)
+	return x
+}
+
+// nearHalf returns the x in [lo, hi) for which a*x mod m comes nearest to
+// m/2, to within a factor of two, or nil if the range is empty.
+func nearHalf(a, m, lo, hi *big.Int) *big.Int {
+	n := new(big.Int).Sub(hi, lo)
+	if n.Sign() <= 0 {
+		return nil
+	}
+	half := new(big.Int).Rsh(m, 1)
+	base := new(big.Int).Mul(a, lo)
+	base.Mod(base, m)
+	find := func(delta *big.Int) *big.Int {
+		// a*x mod m in [half-delta-base, half+delta-base], modulo m.
+		l := new(big.Int).Sub(half, delta)
+		l.Sub(l, base).Mod(l, m)
+		r := new(big.Int).Add(l, new(big.Int).Lsh(delta, 1))
+		var best *big.Int
+		try := func(l, r *big.Int) {
+			if x := firstInRange(a, m, l, r); x != nil && x.Cmp(n) < 0 && (best == nil || x.Cmp(best) < 0) {
+				best = x
+			}
+		}
+		if r.Cmp(m) < 0 {
+			try(l, r)
+		} else {
+			try(l, new(big.Int).Sub(m, big.NewInt(1)))
+			try(new(big.Int), r.Sub(r, m))
+		}
+		return best
+	}
+	// Widen the window from about m/n a few times until it holds a
+	// solution, then narrow it while it still does. A range that never
+	// comes that near is no harder than any other and is skipped.
+	delta := new(big.Int).Quo(m, n)
+	delta.Add(delta, big.NewInt(1))
+	x := find(delta)
+	for i := 0; x == nil && i < 4; i++ {
+		delta.Lsh(delta, 1)
+		x = find(delta)
+	}
+	for x != nil && delta.Sign() > 0 {
+		delta.Rsh(delta, 1)
+		y := find(delta)
+		if y == nil {
+			break
+		}
+		x = y
+	}
+	if x == nil {
+		return nil
+	}
+	return x.Add(x, lo)
+}
+
+// ratio returns num and den with num/den = 10^e10 * 2^e2.
+func ratio(e10, e2 int) (num, den *big.Int) {
+	num, den = big.NewInt(1), big.NewInt(1)
+	p10 := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(e10))), nil)
+	if e10 >= 0 {
+		num.Mul(num, p10)
+	} else {
+		den.Mul(den, p10)
+	}
+	if e2 >= 0 {
+		num.Lsh(num, uint(e2))
+	} else {
+		den.Lsh(den, uint(-e2))
+	}
+	return num, den
+}
+
+func ceilDiv(a, b *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+	if r.Sign() > 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+func maxInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) > 0 {
+		return a
+	}
+	return b
+}
+
+func minInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+	return b
+}
+
+// paxsonDecimals returns, for each binade of t that n-digit decimals with
+// exponent e reach, the one nearest to a halfway point between two floats.
+func paxsonDecimals(t *floatType, n, e int) []string {
+	ten := func(k int) *big.Int { return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(k)), nil) }
+	dlo, dhi := ten(n-1), ten(n)
+	var out []string
+	lo := decExp(new(big.Rat).SetInt(dlo)) + e // decimal exponent of the smallest value
+	k0 := int(math.Floor(float64(lo) * math.Log2(10)))
+	for k := k0 - 1; k <= k0+int(math.Ceil(float64(n)*math.Log2(10)))+1 && k <= t.emax; k++ {
+		// d*10^e in [2^k, 2^(k+1)).
+		num, den := ratio(-e, k)
+		a := ceilDiv(num, den)
+		num, den = ratio(-e, k+1)
+		b := ceilDiv(num, den)
+		from, to := maxInt(a, dlo), minInt(b, dhi)
+		if from.Cmp(to) >= 0 {
+			continue
+		}
+		// Halfway points are odd multiples of half an ULP: want
+		// d*10^e*2^(1-u) mod 2 near 1.
+		u := k - (t.prec - 1)
+		if u < t.emin {
+			u = t.emin
+		}
+		num, den = ratio(e, 1-u)
+		m := new(big.Int).Lsh(den, 1)
+		d := nearHalf(new(big.Int).Mod(num, m), m, from, to)
+		if d != nil {
+			out = append(out, d.String()+"e"+strconv.Itoa(e))
+		}
+	}
+	return out
+}
+
+// paxsonFloats returns, for each decade that the normal floats of t with
+// exponent q reach, the one nearest to a halfway point between two
+// decimals of n digits.
+func paxsonFloats(t *floatType, n, q int) []float64 {
+	mlo := new(big.Int).Lsh(big.NewInt(1), uint(t.prec-1))
+	mhi := new(big.Int).Lsh(big.NewInt(1), uint(t.prec))
+	var out []float64
+	e0 := decExp(new(big.Rat).Mul(new(big.Rat).SetInt(mlo), pow(2, q)))
+	for e := e0; e <= e0+int(math.Ceil(float64(t.prec)*math.Log10(2)))+1; e++ {
+		// m*2^q in [10^e, 10^(e+1)).
+		num, den := ratio(e, -q)
+		a := ceilDiv(num, den)
+		num, den = ratio(e+1, -q)
+		b := ceilDiv(num, den)
+		from, to := maxInt(a, mlo), minInt(b, mhi)
+		if from.Cmp(to) >= 0 {
+			continue
+		}
+		// Want m*2^q*10^(n-1-e) mod 1 near 1/2.
+		num, den = ratio(n-1-e, q+1)
+		m := new(big.Int).Lsh(den, 1)
+		mant := nearHalf(new(big.Int).Mod(num, m), m, from, to)
+		if mant != nil {
+			out = append(out, math.Ldexp(float64(mant.Int64()), q))
+		}
+	}
+	return out
+}
+
+// Families.
+
+// atofCases returns the ParseFloat cases for t.
+func atofCases(t *floatType) []string {
+	var lines []string
+	seen := make(map[string]bool)
+	add := func(in string) {
+		if seen[in] {
+			return
+		}
+		seen[in] = true
+		want := oracleParse(in, t)
+		got, err := strconv.ParseFloat(in, t.bits)
+		if math.Float64bits(got) != math.Float64bits(want) || (err != nil) != math.IsInf(want, 0) {
+			log.Fatalf("%s %s: strconv gives %v, %v; oracle %v", t.name, in, got, err, want)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s", t.name, in, hex(want, t)))
+	}
+	family := func(name string) { lines = append(lines, "# "+t.name+": "+name) }
+	lo10, hi10 := -345, 310
+	if t.bits == 32 {
+		lo10, hi10 = -47, 40
+	}
+
+	family("powers of 10")
+	for e := lo10; e <= hi10; e++ {
+		add("1e" + strconv.Itoa(e))
+	}
+
+	family("powers of 2, shortest and to 20 digits")
+	for e := t.emin; e <= t.emax; e++ {
+		f := math.Ldexp(1, e)
+		add(strconv.FormatFloat(f, 'g', -1, t.bits))
+		add(strconv.FormatFloat(f, 'g', 20, t.bits))
+	}
+
+	family("random sources")
+	for i := 0; i < *count; i++ {
+		digits := strconv.FormatUint(rng.Uint64()>>rng.Intn(64), 10)
+		if rng.Intn(3) == 0 {
+			digits += strconv.FormatUint(rng.Uint64(), 10)
+		}
+		add(digits + "e" + strconv.Itoa(lo10+rng.Intn(hi10-lo10)-len(digits)/2))
+	}
+
+	family("random targets, shortest and to 20 digits")
+	for i := 0; i < *count; i++ {
+		f := randFloat(t)
+		add(strconv.FormatFloat(f, 'g', -1, t.bits))
+		add(strconv.FormatFloat(f, 'g', 20, t.bits))
+	}
+
+	family("random targets, halfway and plus or minus half an ULP")
+	for i := 0; i < *count; i++ {
+		f := math.Abs(randFloat(t))
+		mid := midpoint(f, t)
+		for _, s := range []string{exactString(mid), nudge(mid, 1), nudge(mid, -1)} {
+			add(s)
+		}
+	}
+
+	family("Hanson-style: halfway points in full, cut short, and nudged")
+	for i := 0; i < *count; i++ {
+		f := math.Abs(randFloat(t))
+		mid := midpoint(f, t)
+		full := exactString(mid)
+		add(full)
+		digits, exp := splitExact(mid)
+		cut := 17 + rng.Intn(max(1, len(digits)-17))
+		if cut < len(digits) {
+			add(digits[:1] + "." + digits[1:cut] + "e" + strconv.Itoa(exp))
+			if up := increment(digits[:cut]); len(up) == cut {
+				add(up[:1] + "." + up[1:] + "e" + strconv.Itoa(exp))
+			}
+		}
+	}
+
+	family("Paxson-style: short decimals nearest to halfway")
+	ns := []int{16, 17, 18, 19}
+	step := 7
+	if t.bits == 32 {
+		ns, step = []int{8, 9, 10}, 2
+	}
+	for _, n := range ns {
+		for e := lo10 - n + 1; e <= hi10-n+1; e += step {
+			for _, s := range paxsonDecimals(t, n, e) {
+				add(s)
+			}
+		}
+	}
+	return lines
+}
+
+// ftoaCases returns the FormatFloat cases for t.
+func ftoaCases(t *floatType) []string {
+	var lines []string
+	add := func(f float64, fmtc byte, prec int) {
+		out := strconv.FormatFloat(f, fmtc, prec, t.bits)
+		if err := checkFormat(f, t, fmtc, prec, out); err != nil {
+			log.Fatalf("%s %s %c %d: strconv gives %s: %v", t.name, hex(f, t), fmtc, prec, out, err)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %c %d %s", t.name, hex(f, t), fmtc, prec, out))
+	}
+	family := func(name string) { lines = append(lines, "# "+t.name+": "+name) }
+	lo10, hi10 := -323, 308
+	if t.bits == 32 {
+		lo10, hi10 = -45, 38
+	}
+
+	family("powers of 10")
+	for e := lo10; e <= hi10; e++ {
+		f := oracleParse("1e"+strconv.Itoa(e), t)
+		add(f, 'e', -1)
+		add(f, 'g', 20)
+		add(f, 'e', 25)
+	}
+
+	family("powers of 2")
+	for e := t.emin; e <= t.emax; e++ {
+		f := math.Ldexp(1, e)
+		add(f, 'g', -1)
+		add(f, 'g', 20)
+	}
+
+	family("random targets")
+	for i := 0; i < *count; i++ {
+		f := randFloat(t)
+		add(f, 'e', -1)
+		add(f, 'g', -1)
+		add(f, 'g', 20)
+		add(f, 'e', rng.Intn(30))
+		if a := math.Abs(f); a > 1e-10 && a < 1e20 {
+			add(f, 'f', rng.Intn(25))
+		}
+	}
+
+	family("Paxson-style: floats nearest to halfway between short decimals")
+	ns := []int{15, 16, 17}
+	step := 23
+	if t.bits == 32 {
+		ns, step = []int{6, 7, 8}, 3
+	}
+	for _, n := range ns {
+		for q := t.emin; q <= t.emax-(t.prec-1); q += step {
+			for _, f := range paxsonFloats(t, n, q) {
+				add(f, 'e', n-1)
+				add(f, 'g', n)
+			}
+		}
+	}
+	return lines
+}
+
+// complexCases returns ParseComplex cases built from pairs of ParseFloat
+// cases of the same size.
+func complexCases(atof []string) []string {
+	byType := map[string][][]string{}
+	for _, l := range atof {
+		if !strings.HasPrefix(l, "#") {
+			f := strings.Fields(l)
+			byType[f[0]] = append(byType[f[0]], f)
+		}
+	}
+	var lines []string
+	for _, t := range []*floatType{float64Type, float32Type} {
+		lines = append(lines, "# "+t.name+" parts")
+		cases := byType[t.name]
+		for i := 0; i < *count; i++ {
+			re, im := cases[rng.Intn(len(cases))], cases[rng.Intn(len(cases))]
+			reIn, imIn := re[1], strings.TrimPrefix(im[1], "-")
+			imNeg := rng.Intn(2) == 0
+			sign := "+"
+			if imNeg {
+				sign = "-"
+			}
+			in := "(" + reIn + sign + imIn + "i)"
+			wantRe := oracleParse(reIn, t)
+			wantIm := oracleParse(imIn, t)
+			if imNeg {
+				wantIm = -wantIm
+			}
+			bitSize := 2 * t.bits
+			c, err := strconv.ParseComplex(in, bitSize)
+			if math.Float64bits(real(c)) != math.Float64bits(wantRe) || math.Float64bits(imag(c)) != math.Float64bits(wantIm) ||
+				(err != nil) != (math.IsInf(wantRe, 0) || math.IsInf(wantIm, 0)) {
+				log.Fatalf("%s: strconv gives %v, %v; oracle (%v, %v)", in, c, err, wantRe, wantIm)
+			}
+			lines = append(lines, fmt.Sprintf("%d %s %s %s", bitSize, in, hex(wantRe, t), hex(wantIm, t)))
+		}
+	}
+	return lines
+}
+
+// randFloat returns a random finite value of t, positive or negative.
+func randFloat(t *floatType) float64 {
+	for {
+		var f float64
+		if t.bits == 32 {
+			f = float64(math.Float32frombits(rng.Uint32()))
+		} else {
+			f = math.Float64frombits(rng.Uint64())
+		}
+		if !math.IsInf(f, 0) && !math.IsNaN(f) {
+			return f
+		}
+	}
+}
+
+// midpoint returns the exact value halfway between f >= 0 and the next
+// value of t up, which may be the power of two past the largest float.
+func midpoint(f float64, t *floatType) *big.Rat {
+	var next *big.Rat
+	if t.bits == 32 {
+		n := math.Nextafter32(float32(f), float32(math.Inf(1)))
+		if math.IsInf(float64(n), 0) {
+			next = pow(2, t.emax+1)
+		} else {
+			next = exact(float64(n))
+		}
+	} else {
+		n := math.Nextafter(f, math.Inf(1))
+		if math.IsInf(n, 0) {
+			next = pow(2, t.emax+1)
+		} else {
+			next = exact(n)
+		}
+	}
+	mid := new(big.Rat).Add(exact(f), next)
+	return mid.Quo(mid, big.NewRat(2, 1))
+}
+
+// splitExact returns the significant digits of x > 0, which must have a
+// finite decimal expansion, and the exponent of the first.
+func splitExact(x *big.Rat) (string, int) {
+	e := decExp(x)
+	scaled := new(big.Rat).Mul(x, pow(10, -e))
+	// x*10^-e has a terminating expansion of at most as many digits as
+	// the power of two in its denominator.
+	s := scaled.FloatString(scaled.Denom().BitLen())
+	s = strings.TrimRight(strings.Replace(s, ".", "", 1), "0")
+	return s, e
+}
+
+// exactString writes x > 0 in full.
+func exactString(x *big.Rat) string {
+	digits, e := splitExact(x)
+	if len(digits) == 1 {
+		return digits + "e" + strconv.Itoa(e)
+	}
+	return digits[:1] + "." + digits[1:] + "e" + strconv.Itoa(e)
+}
+
+// nudge writes x > 0 in full, followed by zeros and a digit one unit
+// above or below x in a far place.
+func nudge(x *big.Rat, dir int) string {
+	digits, e := splitExact(x)
+	digits += strings.Repeat("0", 1+rng.Intn(30))
+	if dir > 0 {
+		digits = increment(digits)
+	} else {
+		digits = decrement(digits)
+	}
+	return digits[:1] + "." + digits[1:] + "e" + strconv.Itoa(e)
+}
+
+// increment adds one to the last digit of digits, carrying into a new
+// leading digit if they are all nines.
+func increment(digits string) string {
+	b := []byte(digits)
+	i := len(b) - 1
+	for ; i >= 0 && b[i] == '9'; i-- {
+		b[i] = '0'
+	}
+	if i < 0 {
+		return "1" + string(b)
+	}
+	b[i]++
+	return string(b)
+}
+
+// decrement subtracts one from the last digit of digits, which must not
+// be all zeros.
+func decrement(digits string) string {
+	b := []byte(digits)
+	i := len(b) - 1
+	for ; b[i] == '0'; i-- {
+		b[i] = '9'
+	}
+	b[i]--
+	return string(b)
+}
@@ -0,0 +1,238 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+const fnParseNumber = "ParseNumber"
+
+// A Number is a number literal in JSON's grammar, kept as the text it was
+// decoded from and converted only when one of its methods asks for a
+// value. Like encoding/json's Number, which converts to it directly, it
+// lets a decoder defer the choice between an integer, a float and an
+// exact decimal to the code that reads the field.
+//
+// Number implements [encoding.TextMarshaler], [encoding.TextUnmarshaler],
+// and encoding/json's Marshaler and Unmarshaler, all with JSON's number
+// grammar: an optional minus sign, an integer part without leading zeros,
+// and an optional fraction and exponent.
+type Number string
+
+// ParseNumber returns s as a Number if it is a number in JSON's grammar,
+// and an [ErrSyntax] error otherwise.
+func ParseNumber(s string) (Number, error) {
+	if !isJSONNumber(s) {
+		return "", syntaxError(fnParseNumber, s)
+	}
+	return Number(s), nil
+}
+
+// String returns the literal text of n.
+func (n Number) String() string { return string(n) }
+
+// Int64 returns n parsed by [ParseInt] in base 10 as an int64.
+func (n Number) Int64() (int64, error) {
+	return ParseInt(string(n), 10, 64)
+}
+
+// Uint64 returns n parsed by [ParseUint] in base 10 as a uint64.
+func (n Number) Uint64() (uint64, error) {
+	return ParseUint(string(n), 10, 64)
+}
+
+// Float64 returns n parsed by [ParseFloat] as a float64.
+func (n Number) Float64() (float64, error) {
+	return ParseFloat(string(n), 64)
+}
+
+// Float32 returns n parsed by [ParseFloat] as a float32.
+func (n Number) Float32() (float32, error) {
+	f, err := ParseFloat(string(n), 32)
+	return float32(f), err
+}
+
+// IsInteger reports whether n is an integer literal: a number in JSON's
+// grammar with neither a fraction nor an exponent. "1.0" and "1e3" are not.
+func (n Number) IsInteger() bool {
+	s := string(n)
+	return isJSONNumber(s) && isJSONInteger(s)
+}
+
+// Decimal returns n exactly as coef × 10^exp. The coefficient keeps the
+// digits of n as written, so that "1.50" is 150 × 10^-2, except for
+// trailing zeros it cannot hold, which move to the exponent. Decimal
+// returns an [ErrRange] error if the significant digits of n do not fit in
+// an int64, or if exp would not fit in an int32, and an [ErrSyntax] error
+// if n is not a number in JSON's grammar.
+func (n Number) Decimal() (coef int64, exp int, err error) {
+	const fnDecimal = "Decimal"
+
+	s := string(n)
+	if !isJSONNumber(s) {
+		return 0, 0, syntaxError(fnDecimal, s)
+	}
+	i := 0
+	limit := uint64(1<<63 - 1)
+	neg := s[0] == '-'
+	if neg {
+		i++
+		limit++
+	}
+
+	// Accumulate the digits, holding back zeros until a nonzero digit
+	// follows them, so that trailing zeros can move to the exponent.
+	var c uint64
+	zeros := 0
+	frac := -1 // number of fraction digits, once past the point
+	for ; i < len(s); i++ {
+		ch := s[i]
+		if ch == '.' {
+			frac = 0
+			continue
+		}
+		if ch < '0' || ch > '9' {
+			break
+		}
+		if frac >= 0 {
+			frac++
+		}
+		if ch == '0' {
+			if c != 0 {
+				zeros++
+			}
+			continue
+		}
+		for ; zeros > 0; zeros-- {
+			if c > limit/10 {
+				return 0, 0, rangeError(fnDecimal, s)
+			}
+			c *= 10
+		}
+		d := uint64(ch - '0')
+		if c > (limit-d)/10 {
+			return 0, 0, rangeError(fnDecimal, s)
+		}
+		c = c*10 + d
+	}
+	for ; zeros > 0 && c <= limit/10; zeros-- {
+		c *= 10
+	}
+
+	e := int64(0)
+	if i < len(s) {
+		// An exponent, which the grammar check has validated.
+		v, st := TryParseInt(s[i+1:], 10, 32)
+		if st != StatusOK {
+			return 0, 0, rangeError(fnDecimal, s)
+		}
+		e = v
+	}
+	e += int64(zeros)
+	if frac > 0 {
+		e -= int64(frac)
+	}
+	if e < -1<<31 || e > 1<<31-1 {
+		return 0, 0, rangeError(fnDecimal, s)
+	}
+
+	if neg {
+		return -int64(c), int(e), nil
+	}
+	return int64(c), int(e), nil
+}
+
+// MarshalText returns the literal text of n, or "0" if n is empty.
+func (n Number) MarshalText() ([]byte, error) {
+	if n == "" {
+		return []byte("0"), nil
+	}
+	if !isJSONNumber(string(n)) {
+		return nil, syntaxError(fnParseNumber, string(n))
+	}
+	return []byte(n), nil
+}
+
+// UnmarshalText sets n to a copy of text, which must be a number in
+// JSON's grammar.
+func (n *Number) UnmarshalText(text []byte) error {
+	if !isJSONNumber(bytesToString(text)) {
+		return syntaxError(fnParseNumber, bytesToString(text))
+	}
+	*n = Number(text)
+	return nil
+}
+
+// MarshalJSON returns the literal text of n as a JSON number, or 0 if n
+// is empty, as encoding/json does for its Number.
+func (n Number) MarshalJSON() ([]byte, error) {
+	return n.MarshalText()
+}
+
+// UnmarshalJSON sets n to a copy of the JSON number data. A JSON null
+// leaves n unchanged; anything else, including a quoted number, is an
+// [ErrSyntax] error.
+func (n *Number) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+	return n.UnmarshalText(data)
+}
+
+// isJSONNumber reports whether s is a number in JSON's grammar.
+func isJSONNumber(s string) bool {
+	i := 0
+	if i < len(s) && s[i] == '-' {
+		i++
+	}
+	switch {
+	case i < len(s) && s[i] == '0':
+		i++
+	case i < len(s) && '1' <= s[i] && s[i] <= '9':
+		i = skipDigits(s, i+1)
+	default:
+		return false
+	}
+	if i < len(s) && s[i] == '.' {
+		j := skipDigits(s, i+1)
+		if j == i+1 {
+			return false
+		}
+		i = j
+	}
+	if i < len(s) && lower(s[i]) == 'e' {
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		j := skipDigits(s, i)
+		if j == i {
+			return false
+		}
+		i = j
+	}
+	return i == len(s)
+}
+
+// isJSONInteger reports whether the JSON number s has neither a fraction
+// nor an exponent.
+func isJSONInteger(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '.' || lower(s[i]) == 'e' {
+			return false
+		}
+	}
+	return true
+}
+
+// skipDigits returns the index of the first byte of s at or after i that
+// is not a decimal digit.
+func skipDigits(s string, i int) int {
+	for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+		i++
+	}
+	return i
+}
+
+func isJSONNull(data []byte) bool {
+	return string(data) == "null"
+}
@@ -0,0 +1,255 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"encoding"
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var (
+	_ encoding.TextUnmarshaler = (*Number)(nil)
+	_ encoding.TextMarshaler   = Number("")
+	_ json.Unmarshaler         = (*Number)(nil)
+	_ json.Marshaler           = Number("")
+	_ json.Unmarshaler         = (*Float64)(nil)
+	_ encoding.TextUnmarshaler = (*Uint64)(nil)
+)
+
+var jsonNumberTests = []struct {
+	in      string
+	ok      bool
+	integer bool
+}{
+	{"0", true, true},
+	{"-0", true, true},
+	{"123", true, true},
+	{"-123", true, true},
+	{"1.5", true, false},
+	{"-0.001", true, false},
+	{"1e3", true, false},
+	{"1E+03", true, false},
+	{"2.5e-10", true, false},
+	{"", false, false},
+	{"-", false, false},
+	{"+1", false, false},
+	{"01", false, false},
+	{"-01", false, false},
+	{"1.", false, false},
+	{".5", false, false},
+	{"1e", false, false},
+	{"1e+", false, false},
+	{"0x10", false, false},
+	{"1_000", false, false},
+	{"Inf", false, false},
+	{"NaN", false, false},
+	{" 1", false, false},
+	{"1 ", false, false},
+	{`"1"`, false, false},
+}
+
+func TestNumberGrammar(t *testing.T) {
+	for _, test := range jsonNumberTests {
+		_, err := ParseNumber(test.in)
+		if (err == nil) != test.ok {
+			t.Errorf("ParseNumber(%q): err = %v, want ok %t", test.in, err, test.ok)
+		}
+		if got := Number(test.in).IsInteger(); got != test.integer {
+			t.Errorf("Number(%q).IsInteger() = %t, want %t", test.in, got, test.integer)
+		}
+		// encoding/json trims the space around a value it passes to
+		// UnmarshalJSON, and checks the rest of the input itself.
+		var n Number
+		err = json.Unmarshal([]byte(test.in), &n)
+		want := json.Valid([]byte(test.in)) && test.in[0] != '"'
+		if (err == nil) != want {
+			t.Errorf("json.Unmarshal(%q) into Number: err = %v, want ok %t", test.in, err, want)
+		}
+	}
+}
+
+func TestNumberConversions(t *testing.T) {
+	for _, s := range []string{"0", "-12", "9223372036854775807", "9223372036854775808", "18446744073709551616",
+		"1.5", "-2.5e-3", "1e400", "123456789012345678901234567890"} {
+		n := Number(s)
+		i, err := n.Int64()
+		wantI, wantErr := strconv.ParseInt(s, 10, 64)
+		if i != wantI || (err == nil) != (wantErr == nil) {
+			t.Errorf("Number(%q).Int64() = %d, %v want %d, %v", s, i, err, wantI, wantErr)
+		}
+		u, err := n.Uint64()
+		wantU, wantErr := strconv.ParseUint(s, 10, 64)
+		if u != wantU || (err == nil) != (wantErr == nil) {
+			t.Errorf("Number(%q).Uint64() = %d, %v want %d, %v", s, u, err, wantU, wantErr)
+		}
+		f, err := n.Float64()
+		wantF, wantErr := strconv.ParseFloat(s, 64)
+		if f != wantF || (err == nil) != (wantErr == nil) {
+			t.Errorf("Number(%q).Float64() = %v, %v want %v, %v", s, f, err, wantF, wantErr)
+		}
+		f32, err := n.Float32()
+		wantF, wantErr = strconv.ParseFloat(s, 32)
+		if float64(f32) != wantF || (err == nil) != (wantErr == nil) {
+			t.Errorf("Number(%q).Float32() = %v, %v want %v, %v", s, f32, err, wantF, wantErr)
+		}
+	}
+}
+
+func TestNumberDecimal(t *testing.T) {
+	tests := []struct {
+		in   string
+		coef int64
+		exp  int
+		err  error
+	}{
+		{"0", 0, 0, nil},
+		{"0.000", 0, -3, nil},
+		{"1.50", 150, -2, nil},
+		{"-0.05", -5, -2, nil},
+		{"12.345e3", 12345, 0, nil},
+		{"1e-7", 1, -7, nil},
+		{"100", 100, 0, nil},
+		{"9223372036854775807", math.MaxInt64, 0, nil},
+		{"-9223372036854775808", math.MinInt64, 0, nil},
+		{"100000000000000000000000", 1000000000000000000, 5, nil},
+		{"9223372036854775808", 0, 0, ErrRange},
+		{"1.2345678901234567890123", 0, 0, ErrRange},
+		{"1e2147483647", 1, 2147483647, nil},
+		{"1e2147483648", 0, 0, ErrRange},
+		{"0.1e-2147483648", 0, 0, ErrRange},
+		{"01", 0, 0, ErrSyntax},
+		{"", 0, 0, ErrSyntax},
+	}
+	for _, test := range tests {
+		coef, exp, err := Number(test.in).Decimal()
+		if coef != test.coef || exp != test.exp || extractInnerError(err) != test.err {
+			t.Errorf("Number(%q).Decimal() = %d, %d, %v want %d, %d, %v",
+				test.in, coef, exp, err, test.coef, test.exp, test.err)
+		}
+	}
+}
+
+func TestNumberJSON(t *testing.T) {
+	type record struct {
+		ID    Number  `json:"id"`
+		Price Number  `json:"price"`
+		Skip  Number  `json:"skip"`
+		Empty Number  `json:"empty"`
+		Ptr   *Number `json:"ptr"`
+	}
+	in := `{"id":12345678901234567890,"price":1.50,"skip":null,"ptr":-0.0}`
+	var r record
+	r.Skip = "7"
+	if err := json.Unmarshal([]byte(in), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.ID != "12345678901234567890" || r.Price != "1.50" || r.Skip != "7" || r.Ptr == nil || *r.Ptr != "-0.0" {
+		t.Errorf("decoded %+v", r)
+	}
+	out, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"id":12345678901234567890,"price":1.50,"skip":7,"empty":0,"ptr":-0.0}`; string(out) != want {
+		t.Errorf("json.Marshal = %s\nwant %s", out, want)
+	}
+	if err := json.Unmarshal([]byte(`{"id":"12"}`), &r); err == nil {
+		t.Errorf("quoted number decoded into Number without error")
+	}
+	if _, err := json.Marshal(record{ID: "x"}); err == nil {
+		t.Errorf("invalid Number marshaled without error")
+	}
+}
+
+func TestNumberTypesJSON(t *testing.T) {
+	type record struct {
+		F   Float64 `json:"f"`
+		F32 Float32 `json:"f32"`
+		I   Int     `json:"i"`
+		I64 Int64   `json:"i64"`
+		U   Uint    `json:"u"`
+		U64 Uint64  `json:"u64"`
+	}
+	in := `{"f":0.1,"f32":3.4028235e38,"i":-42,"i64":-9223372036854775808,"u":42,"u64":18446744073709551615}`
+	var r record
+	if err := json.Unmarshal([]byte(in), &r); err != nil {
+		t.Fatal(err)
+	}
+	want := record{0.1, math.MaxFloat32, -42, math.MinInt64, 42, math.MaxUint64}
+	if r != want {
+		t.Errorf("decoded %+v\nwant %+v", r, want)
+	}
+	out, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type plain struct {
+		F   float64 `json:"f"`
+		F32 float32 `json:"f32"`
+		I   int     `json:"i"`
+		I64 int64   `json:"i64"`
+		U   uint    `json:"u"`
+		U64 uint64  `json:"u64"`
+	}
+	std, _ := json.Marshal(plain{float64(r.F), float32(r.F32), int(r.I), int64(r.I64), uint(r.U), uint64(r.U64)})
+	if string(out) != string(std) {
+		t.Errorf("json.Marshal = %s\nencoding/json gives %s", out, std)
+	}
+
+	for _, bad := range []string{`{"i":1.5}`, `{"i":"1"}`, `{"i":+1}`, `{"u":-1}`, `{"i64":9223372036854775808}`, `{"f":1e400}`} {
+		r := record{I: 7}
+		if err := json.Unmarshal([]byte(bad), &r); err == nil {
+			t.Errorf("json.Unmarshal(%s) succeeded", bad)
+		}
+	}
+	r = record{I: 7}
+	if err := json.Unmarshal([]byte(`{"i":null}`), &r); err != nil || r.I != 7 {
+		t.Errorf("null: I = %d, err %v; want 7, nil", r.I, err)
+	}
+	if _, err := json.Marshal(record{F: Float64(math.NaN())}); err == nil {
+		t.Errorf("NaN marshaled without error")
+	}
+}
+
+func TestAppendJSONFloat(t *testing.T) {
+	for _, f := range []float64{0, -0.0, 1, -1.5, 1e-6, 9.99e-7, 1e20, 1e21, 1.5e-300, math.MaxFloat64, math.SmallestNonzeroFloat64} {
+		got, _ := appendJSONFloat(nil, f, 64)
+		want, _ := json.Marshal(f)
+		if string(got) != string(want) {
+			t.Errorf("appendJSONFloat(%v, 64) = %s, want %s", f, got, want)
+		}
+		f32 := float32(f)
+		got, _ = appendJSONFloat(nil, float64(f32), 32)
+		want, _ = json.Marshal(f32)
+		if string(got) != string(want) {
+			t.Errorf("appendJSONFloat(%v, 32) = %s, want %s", f32, got, want)
+		}
+	}
+}
+
+func TestNumberTypesText(t *testing.T) {
+	var f Float64
+	if err := f.UnmarshalText([]byte("0x1p-2")); err != nil || f != 0.25 {
+		t.Errorf("Float64.UnmarshalText(0x1p-2) = %v, %v", f, err)
+	}
+	if err := f.UnmarshalText([]byte("abc")); err == nil || f != 0.25 {
+		t.Errorf("Float64.UnmarshalText(abc) = %v, %v; want unchanged value and an error", f, err)
+	}
+	var u Uint64
+	if err := u.UnmarshalText([]byte("18446744073709551616")); extractInnerError(err) != ErrRange {
+		t.Errorf("Uint64.UnmarshalText: err = %v, want ErrRange", err)
+	}
+	if b, _ := Int64(-5).MarshalText(); string(b) != "-5" {
+		t.Errorf("Int64(-5).MarshalText() = %s", b)
+	}
+	text := []byte("12345")
+	var i Int
+	if allocs := testing.AllocsPerRun(100, func() { i.UnmarshalText(text) }); allocs != 0 || i != 12345 {
+		t.Errorf("Int.UnmarshalText: %v allocations, value %d; want 0, 12345", allocs, i)
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"errors"
+	"math"
+)
+
+// Float64, Float32, Int, Int64, Uint and Uint64 are the basic number types
+// with text and JSON methods that use the fastparse parsers and formatters
+// in place of strconv's, for struct fields decoded by encoding/json or by
+// any decoder that uses [encoding.TextUnmarshaler]. Their UnmarshalText
+// methods accept what ParseFloat, or ParseInt and ParseUint in base 10,
+// accept, parsing the text in place without allocating. Their UnmarshalJSON
+// methods first require JSON's number grammar, or for the integer types an
+// integer literal, and ignore a JSON null. On error, none of them changes
+// the value. Their MarshalJSON methods format numbers as encoding/json does.
+type (
+	Float64 float64
+	Float32 float32
+	Int     int
+	Int64   int64
+	Uint    uint
+	Uint64  uint64
+)
+
+// MarshalText formats f by [AppendFloat] in the 'g' format.
+func (f Float64) MarshalText() ([]byte, error) {
+	return AppendFloat(nil, float64(f), 'g', -1, 64), nil
+}
+
+// UnmarshalText sets f to text parsed by [ParseFloat].
+func (f *Float64) UnmarshalText(text []byte) error {
+	v, st := TryParseFloatBytes(text, 64)
+	if st != StatusOK {
+		return st.Err(fnParseFloat, bytesToString(text))
+	}
+	*f = Float64(v)
+	return nil
+}
+
+// MarshalJSON formats f as a JSON number.
+func (f Float64) MarshalJSON() ([]byte, error) {
+	return appendJSONFloat(nil, float64(f), 64)
+}
+
+// UnmarshalJSON sets f to the JSON number data.
+func (f *Float64) UnmarshalJSON(data []byte) error {
+	if null, err := checkJSONNumber(data, false, fnParseFloat); null || err != nil {
+		return err
+	}
+	return f.UnmarshalText(data)
+}
+
+// MarshalText formats f by [AppendFloat] in the 'g' format.
+func (f Float32) MarshalText() ([]byte, error) {
+	return AppendFloat(nil, float64(f), 'g', -1, 32), nil
+}
+
+// UnmarshalText sets f to text parsed by [ParseFloat] as a float32.
+func (f *Float32) UnmarshalText(text []byte) error {
+	v, st := TryParseFloatBytes(text, 32)
+	if st != StatusOK {
+		return st.Err(fnParseFloat, bytesToString(text))
+	}
+	*f = Float32(v)
+	return nil
+}
+
+// MarshalJSON formats f as a JSON number.
+func (f Float32) MarshalJSON() ([]byte, error) {
+	return appendJSONFloat(nil, float64(f), 32)
+}
+
+// UnmarshalJSON sets f to the JSON number data.
+func (f *Float32) UnmarshalJSON(data []byte) error {
+	if null, err := checkJSONNumber(data, false, fnParseFloat); null || err != nil {
+		return err
+	}
+	return f.UnmarshalText(data)
+}
+
+// MarshalText formats i in base 10.
+func (i Int) MarshalText() ([]byte, error) {
+	return AppendInt(nil, int64(i), 10), nil
+}
+
+// UnmarshalText sets i to text parsed by [ParseInt] in base 10.
+func (i *Int) UnmarshalText(text []byte) error {
+	v, st := TryParseIntBytes(text, 10, 0)
+	if st != StatusOK {
+		return st.Err(fnParseInt, bytesToString(text))
+	}
+	*i = Int(v)
+	return nil
+}
+
+// MarshalJSON formats i as a JSON number.
+func (i Int) MarshalJSON() ([]byte, error) {
+	return i.MarshalText()
+}
+
+// UnmarshalJSON sets i to the JSON integer data.
+func (i *Int) UnmarshalJSON(data []byte) error {
+	if null, err := checkJSONNumber(data, true, fnParseInt); null || err != nil {
+		return err
+	}
+	return i.UnmarshalText(data)
+}
+
+// MarshalText formats i in base 10.
+func (i Int64) MarshalText() ([]byte, error) {
+	return AppendInt(nil, int64(i), 10), nil
+}
+
+// UnmarshalText sets i to text parsed by [ParseInt] in base 10.
+func (i *Int64) UnmarshalText(text []byte) error {
+	v, st := TryParseIntBytes(text, 10, 64)
+	if st != StatusOK {
+		return st.Err(fnParseInt, bytesToString(text))
+	}
+	*i = Int64(v)
+	return nil
+}
+
+// MarshalJSON formats i as a JSON number.
+func (i Int64) MarshalJSON() ([]byte, error) {
+	return i.MarshalText()
+}
+
+// UnmarshalJSON sets i to the JSON integer data.
+func (i *Int64) UnmarshalJSON(data []byte) error {
+	if null, err := checkJSONNumber(data, true, fnParseInt); null || err != nil {
+		return err
+	}
+	return i.UnmarshalText(data)
+}
+
+// MarshalText formats u in base 10.
+func (u Uint) MarshalText() ([]byte, error) {
+	return AppendUint(nil, uint64(u), 10), nil
+}
+
+// UnmarshalText sets u to text parsed by [ParseUint] in base 10.
+func (u *Uint) UnmarshalText(text []byte) error {
+	v, st := TryParseUintBytes(text, 10, 0)
+	if st != StatusOK {
+		return st.Err(fnParseUint, bytesToString(text))
+	}
+	*u = Uint(v)
+	return nil
+}
+
+// MarshalJSON formats u as a JSON number.
+func (u Uint) MarshalJSON() ([]byte, error) {
+	return u.MarshalText()
+}
+
+// UnmarshalJSON sets u to the JSON integer data.
+func (u *Uint) UnmarshalJSON(data []byte) error {
+	if null, err := checkJSONNumber(data, true, fnParseUint); null || err != nil {
+		return err
+	}
+	return u.UnmarshalText(data)
+}
+
+// MarshalText formats u in base 10.
+func (u Uint64) MarshalText() ([]byte, error) {
+	return AppendUint(nil, uint64(u), 10), nil
+}
+
+// UnmarshalText sets u to text parsed by [ParseUint] in base 10.
+func (u *Uint64) UnmarshalText(text []byte) error {
+	v, st := TryParseUintBytes(text, 10, 64)
+	if st != StatusOK {
+		return st.Err(fnParseUint, bytesToString(text))
+	}
+	*u = Uint64(v)
+	return nil
+}
+
+// MarshalJSON formats u as a JSON number.
+func (u Uint64) MarshalJSON() ([]byte, error) {
+	return u.MarshalText()
+}
+
+// UnmarshalJSON sets u to the JSON integer data.
+func (u *Uint64) UnmarshalJSON(data []byte) error {
+	if null, err := checkJSONNumber(data, true, fnParseUint); null || err != nil {
+		return err
+	}
+	return u.UnmarshalText(data)
+}
+
+// checkJSONNumber checks data, given to an UnmarshalJSON method, for a
+// number in JSON's grammar, or with integer for an integer literal. It
+// reports a JSON null, which the method ignores, and any other data as
+// an ErrSyntax error from fn.
+func checkJSONNumber(data []byte, integer bool, fn string) (null bool, err error) {
+	s := bytesToString(data)
+	switch {
+	case isJSONNull(data):
+		return true, nil
+	case !isJSONNumber(s), integer && !isJSONInteger(s):
+		return false, syntaxError(fn, s)
+	}
+	return false, nil
+}
+
+// appendJSONFloat appends f, a float of bitSize bits, to dst as
+// encoding/json formats it: in the 'f' format unless its magnitude is
+// below 1e-6 or at least 1e21, and then in the 'e' format without the
+// zero padding of a negative exponent. NaN and the infinities, which JSON
+// cannot represent, are an error.
+func appendJSONFloat(dst []byte, f float64, bitSize int) ([]byte, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, errors.New("fastparse: unsupported JSON value: " + FormatFloat(f, 'g', -1, bitSize))
+	}
+	abs := math.Abs(f)
+	fmt := byte('f')
+	if abs != 0 {
+		if bitSize == 64 && (abs < 1e-6 || abs >= 1e21) ||
+			bitSize == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			fmt = 'e'
+		}
+	}
+	dst = AppendFloat(dst, f, fmt, -1, bitSize)
+	if fmt == 'e' {
+		// Clean up e-09 to e-9.
+		n := len(dst)
+		if n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
+	return dst, nil
+}
@@ -9,13 +9,28 @@ package fastparse
 // It accepts 1, t, T, TRUE, true, True, 0, f, F, FALSE, false, False.
 // Any other value returns an error.
 func ParseBool(str string) (bool, error) {
+	b, st := TryParseBool(str)
+	if st != StatusOK {
+		return false, syntaxError("ParseBool", str)
+	}
+	return b, nil
+}
+
+// TryParseBool is like [ParseBool] but reports an invalid input as
+// StatusSyntax instead of an error, and so never allocates.
+func TryParseBool(str string) (bool, Status) {
 	switch str {
 	case "1", "t", "T", "true", "TRUE", "True":
-		return true, nil
+		return true, StatusOK
 	case "0", "f", "F", "false", "FALSE", "False":
-		return false, nil
+		return false, StatusOK
 	}
-	return false, syntaxError("ParseBool", str)
+	return false, StatusSyntax
+}
+
+// TryParseBoolBytes is like [TryParseBool] but parses a byte slice.
+func TryParseBoolBytes(b []byte) (bool, Status) {
+	return TryParseBool(bytesToString(b))
 }
 
 // FormatBool returns "true" or "false" according to the value of b.
@@ -2,18 +2,16 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build !amd64 && !arm64
-
 package fastparse
 
-// parseDirectFloat provides ultra-fast direct conversion for common float patterns.
-// This is the purego fallback implementation.
+// parseDirectFloatScalar provides ultra-fast direct conversion for common float patterns.
+// This is the pure Go version of parseDirectFloat.
 //
 // Returns (result, mantissa, exp, neg, true) on success.
 // Returns (0, 0, 0, false, false) on failure (doesn't parse mantissa/exp, only integers).
 //
 // Note: This function only handles pure integers, so mantissa/exp are always 0.
-func parseDirectFloat(s string) (float64, uint64, int, bool, bool) {
+func parseDirectFloatScalar(s string) (float64, uint64, int, bool, bool) {
 	if len(s) == 0 || len(s) > 16 {
 		return 0, 0, 0, false, false
 	}
@@ -6,6 +6,8 @@
 
 package fastparse
 
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
 // parseDirectFloat provides ultra-fast direct conversion for common float patterns.
 // This uses optimized assembly for ARM64.
 //
@@ -15,6 +17,9 @@ package fastparse
 func parseDirectFloatAsm(s string) (result float64, ok bool)
 
 func parseDirectFloat(s string) (float64, uint64, int, bool, bool) {
+	if !dispatch.Allow(dispatch.NEON) {
+		return parseDirectFloatScalar(s)
+	}
 	result, ok := parseDirectFloatAsm(s)
 	return result, 0, 0, false, ok
 }
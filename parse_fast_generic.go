@@ -0,0 +1,23 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 && !arm64
+
+package fastparse
+
+func parseDirectFloat(s string) (float64, uint64, int, bool, bool) {
+	return parseDirectFloatScalar(s)
+}
+
+func parseSimpleFast(s string) (float64, uint64, int, bool, bool) {
+	return parseSimpleFastScalar(s)
+}
+
+func parseHexFast(s string) (float64, bool) {
+	return parseHexFastScalar(s)
+}
+
+func parseLongDecimalFast(s string) (float64, bool) {
+	return parseLongDecimalFastScalar(s)
+}
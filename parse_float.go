@@ -96,6 +96,7 @@ func (b *decimal) set(s string) (ok bool) {
 	// digits
 	sawdot := false
 	sawdigits := false
+	nd := 0 // significant digits seen, including those dropped past len(b.d)
 	for ; i < len(s); i++ {
 		switch {
 		case s[i] == '_':
@@ -106,15 +107,16 @@ func (b *decimal) set(s string) (ok bool) {
 				return
 			}
 			sawdot = true
-			b.dp = b.nd
+			b.dp = nd
 			continue
 
 		case '0' <= s[i] && s[i] <= '9':
 			sawdigits = true
-			if s[i] == '0' && b.nd == 0 { // ignore leading zeros
+			if s[i] == '0' && nd == 0 { // ignore leading zeros
 				b.dp--
 				continue
 			}
+			nd++
 			if b.nd < len(b.d) {
 				b.d[b.nd] = s[i]
 				b.nd++
@@ -129,7 +131,7 @@ func (b *decimal) set(s string) (ok bool) {
 		return
 	}
 	if !sawdot {
-		b.dp = b.nd
+		b.dp = nd
 	}
 
 	// optional exponent moves decimal point.
@@ -530,7 +532,7 @@ func atof32exact(mantissa uint64, exp int, neg bool) (f float32, ok bool) {
 // and returns it as a float64.
 // The string s has already been parsed into a mantissa, exponent, and sign (neg==true for negative).
 // If trunc is true, trailing non-zero bits have been omitted from the mantissa.
-func atofHex(s string, flt *floatInfo, mantissa uint64, exp int, neg, trunc bool) (float64, error) {
+func atofHex(s string, flt *floatInfo, mantissa uint64, exp int, neg, trunc bool) (float64, Status) {
 	maxExp := 1<<flt.expbits + flt.bias - 2
 	minExp := flt.bias + 1
 	exp += int(flt.mantbits) // mantissa now implicitly divided by 2^mantbits.
@@ -577,11 +579,11 @@ func atofHex(s string, flt *floatInfo, mantissa uint64, exp int, neg, trunc bool
 	if mantissa>>flt.mantbits == 0 { // Denormal or zero.
 		exp = flt.bias
 	}
-	var err error
+	st := StatusOK
 	if exp > maxExp { // infinity and range error
 		mantissa = 1 << flt.mantbits
 		exp = maxExp + 1
-		err = rangeError(fnParseFloat, s)
+		st = StatusRange
 	}
 
 	bits := mantissa & (1<<flt.mantbits - 1)
@@ -590,26 +592,28 @@ func atofHex(s string, flt *floatInfo, mantissa uint64, exp int, neg, trunc bool
 		bits |= 1 << flt.mantbits << flt.expbits
 	}
 	if flt == &float32info {
-		return float64(math.Float32frombits(uint32(bits))), err
+		return float64(math.Float32frombits(uint32(bits))), st
 	}
-	return math.Float64frombits(bits), err
+	return math.Float64frombits(bits), st
 }
 
 const fnParseFloat = "ParseFloat"
 
-func atof32(s string) (f float32, n int, err error) {
+func atof32(s string) (f float32, n int, st Status) {
 	if val, n, ok := special(s); ok {
-		return float32(val), n, nil
+		stat(statFloatAtofSpecial)
+		return float32(val), n, StatusOK
 	}
 
 	mantissa, exp, neg, trunc, hex, n, ok := readFloat(s)
 	if !ok {
-		return 0, n, syntaxError(fnParseFloat, s)
+		return 0, n, StatusSyntax
 	}
 
 	if hex {
-		f, err := atofHex(s[:n], &float32info, mantissa, exp, neg, trunc)
-		return float32(f), n, err
+		stat(statFloatAtofHex)
+		f, st := atofHex(s[:n], &float32info, mantissa, exp, neg, trunc)
+		return float32(f), n, st
 	}
 
 	if optimize {
@@ -617,50 +621,56 @@ func atof32(s string) (f float32, n int, err error) {
 		// the Eisel-Lemire algorithm.
 		if !trunc {
 			if f, ok := atof32exact(mantissa, exp, neg); ok {
-				return f, n, nil
+				stat(statFloatAtofExact)
+				return f, n, StatusOK
 			}
 		}
 		f, ok := eiselLemire32(mantissa, exp, neg)
 		if ok {
 			if !trunc {
-				return f, n, nil
+				stat(statFloatAtofEiselLemire)
+				return f, n, StatusOK
 			}
 			// Even if the mantissa was truncated, we may
 			// have found the correct result. Confirm by
 			// converting the upper mantissa bound.
 			fUp, ok := eiselLemire32(mantissa+1, exp, neg)
 			if ok && f == fUp {
-				return f, n, nil
+				stat(statFloatAtofEiselLemire)
+				return f, n, StatusOK
 			}
 		}
 	}
 
 	// Slow fallback.
+	stat(statFloatAtofDecimal)
 	var d decimal
 	if !d.set(s[:n]) {
-		return 0, n, syntaxError(fnParseFloat, s)
+		return 0, n, StatusSyntax
 	}
 	b, ovf := d.floatBits(&float32info)
 	f = math.Float32frombits(uint32(b))
 	if ovf {
-		err = rangeError(fnParseFloat, s)
+		st = StatusRange
 	}
-	return f, n, err
+	return f, n, st
 }
 
-func atof64(s string) (f float64, n int, err error) {
+func atof64(s string) (f float64, n int, st Status) {
 	if val, n, ok := special(s); ok {
-		return val, n, nil
+		stat(statFloatAtofSpecial)
+		return val, n, StatusOK
 	}
 
 	mantissa, exp, neg, trunc, hex, n, ok := readFloat(s)
 	if !ok {
-		return 0, n, syntaxError(fnParseFloat, s)
+		return 0, n, StatusSyntax
 	}
 
 	if hex {
-		f, err := atofHex(s[:n], &float64info, mantissa, exp, neg, trunc)
-		return f, n, err
+		stat(statFloatAtofHex)
+		f, st := atofHex(s[:n], &float64info, mantissa, exp, neg, trunc)
+		return f, n, st
 	}
 
 	if optimize {
@@ -668,35 +678,39 @@ func atof64(s string) (f float64, n int, err error) {
 		// the Eisel-Lemire algorithm.
 		if !trunc {
 			if f, ok := atof64exact(mantissa, exp, neg); ok {
-				return f, n, nil
+				stat(statFloatAtofExact)
+				return f, n, StatusOK
 			}
 		}
 		f, ok := eiselLemire64(mantissa, exp, neg)
 		if ok {
 			if !trunc {
-				return f, n, nil
+				stat(statFloatAtofEiselLemire)
+				return f, n, StatusOK
 			}
 			// Even if the mantissa was truncated, we may
 			// have found the correct result. Confirm by
 			// converting the upper mantissa bound.
 			fUp, ok := eiselLemire64(mantissa+1, exp, neg)
 			if ok && f == fUp {
-				return f, n, nil
+				stat(statFloatAtofEiselLemire)
+				return f, n, StatusOK
 			}
 		}
 	}
 
 	// Slow fallback.
+	stat(statFloatAtofDecimal)
 	var d decimal
 	if !d.set(s[:n]) {
-		return 0, n, syntaxError(fnParseFloat, s)
+		return 0, n, StatusSyntax
 	}
 	b, ovf := d.floatBits(&float64info)
 	f = math.Float64frombits(b)
 	if ovf {
-		err = rangeError(fnParseFloat, s)
+		st = StatusRange
 	}
-	return f, n, err
+	return f, n, st
 }
 
 // ParseFloat converts the string s to a floating-point number
@@ -727,7 +741,9 @@ func atof64(s string) (f float64, n int, err error) {
 //
 // [floating-point literals]: https://go.dev/ref/spec#Floating-point_literals
 func ParseFloat(s string, bitSize int) (float64, error) {
+	stat(statFloatCalls)
 	if runtime.GOARCH == "amd64" {
+		stat(statFloatStdlib)
 		f, err := stdstrconv.ParseFloat(s, bitSize)
 		if err == nil {
 			return f, nil
@@ -735,45 +751,74 @@ func ParseFloat(s string, bitSize int) (float64, error) {
 		if ne, ok := err.(*stdstrconv.NumError); ok {
 			switch ne.Err {
 			case stdstrconv.ErrSyntax:
+				stat(statFloatSyntax)
 				return 0, syntaxError(fnParseFloat, s)
 			case stdstrconv.ErrRange:
+				stat(statFloatRange)
 				return f, rangeError(fnParseFloat, s)
 			}
 		}
 		return f, err
 	}
 
+	f, st := TryParseFloat(s, bitSize)
+	switch st {
+	case StatusOK:
+		return f, nil
+	case StatusRange:
+		stat(statFloatRange)
+		return f, rangeError(fnParseFloat, s)
+	}
+	stat(statFloatSyntax)
+	return 0, syntaxError(fnParseFloat, s)
+}
+
+// TryParseFloat is like [ParseFloat] but reports failure as a [Status]
+// instead of an error, and so never allocates: StatusSyntax with 0, or
+// StatusRange with ±Inf.
+//
+// On amd64, where ParseFloat delegates to strconv, TryParseFloat uses
+// fastparse's copy of strconv's algorithm, whose results are identical
+// but whose failures are not wrapped in a *NumError.
+func TryParseFloat(s string, bitSize int) (float64, Status) {
 	// For float64, use the optimized parseFloat fast paths directly
 	// since we know the entire string should be consumed
-	if bitSize == 64 {
+	if bitSize == 64 && runtime.GOARCH != "amd64" {
 		f, err := parseFloat(s)
-		if err == nil {
-			return f, nil
-		}
-		// Wrap in NumError for consistency with strconv API
-		// Use type assertion instead of == to avoid allocation
 		switch err {
-		case ErrSyntax:
-			return 0, syntaxError(fnParseFloat, s)
+		case nil:
+			return f, StatusOK
 		case ErrRange:
-			return f, rangeError(fnParseFloat, s)
-		default:
-			return 0, err
+			return f, StatusRange
 		}
+		return 0, StatusSyntax
 	}
 
-	// For float32 or when prefix parsing is needed, use parseFloatPrefix
-	f, n, err := parseFloatPrefix(s, bitSize)
-	if n != len(s) && (err == nil || err.(*NumError).Err != ErrSyntax) {
-		return 0, syntaxError(fnParseFloat, s)
+	// For float32 or when prefix parsing is needed, use atof
+	f, n, st := atof(s, bitSize)
+	if n != len(s) && st != StatusSyntax {
+		return 0, StatusSyntax
 	}
-	return f, err
+	return f, st
+}
+
+// TryParseFloatBytes is like [TryParseFloat] but parses a byte slice.
+func TryParseFloatBytes(b []byte, bitSize int) (float64, Status) {
+	return TryParseFloat(bytesToString(b), bitSize)
 }
 
 func parseFloatPrefix(s string, bitSize int) (float64, int, error) {
+	f, n, st := atof(s, bitSize)
+	if st != StatusOK {
+		return f, n, st.numError(fnParseFloat, s, 10, bitSize)
+	}
+	return f, n, nil
+}
+
+func atof(s string, bitSize int) (float64, int, Status) {
 	if bitSize == 32 {
-		f, n, err := atof32(s)
-		return float64(f), n, err
+		f, n, st := atof32(s)
+		return float64(f), n, st
 	}
 	// Use atof64 for prefix parsing (needed for complex numbers)
 	// It properly handles partial string consumption
@@ -6,9 +6,7 @@ package fastparse
 
 import (
 	"math"
-	"math/big"
 	"math/bits"
-	"sync"
 
 	"github.com/mshafiee/fastparse/internal/classifier"
 	"github.com/mshafiee/fastparse/internal/conversion"
@@ -19,14 +17,9 @@ import (
 
 const maxSignificantDigits = 19
 
-// Pool for parsedComponents to reduce allocations
-var pcPool = sync.Pool{
-	New: func() interface{} {
-		return &parsedComponents{
-			mantDigitsArray: [32]byte{}, // Stack array for common cases
-		}
-	},
-}
+// maxMantDigits is the number of significant digits the FSA parser keeps,
+// as many as a decimal holds. Later nonzero digits set hasMore.
+const maxMantDigits = len(decimal{}.d)
 
 // parseFloatGeneric parses a base-10 or hexadecimal float64 from string using an FSA-based parser.
 // This is the generic pure Go implementation available to all platforms.
@@ -36,6 +29,10 @@ var pcPool = sync.Pool{
 // 2. Optimized simple parser with Eisel-Lemire (20-40 ns, 25-30% hit rate)
 // 3. Comprehensive FSA parser (100+ ns, 5-10% hit rate)
 //
+// No tier allocates: the FSA parser keeps its state on the stack, and the
+// hardest inputs are converted with a fixed-size multiprecision decimal, so
+// a valid input of any length parses without allocating.
+//
 // PHASE 4 OPTIMIZATION: Inline simple pattern detection (4-10 ns savings)
 func parseFloatGeneric(s string) (float64, error) {
 	if len(s) == 0 {
@@ -49,6 +46,7 @@ func parseFloatGeneric(s string) (float64, error) {
 	if len(s) <= 16 {
 		result, mantissa, exp, neg, ok := parseDirectFloat(s)
 		if ok && exp >= minEiselLemireExp {
+			stat(statFloatDirect)
 			return result, nil
 		}
 
@@ -58,6 +56,7 @@ func parseFloatGeneric(s string) (float64, error) {
 				// parseDirectFloat parsed but couldn't convert - try Eisel-Lemire directly!
 				// This is the key optimization: bypass FSA for large exponents
 				if result, ok := eisel_lemire.TryParse(mantissa, exp); ok {
+					stat(statFloatDirectEiselLemire)
 					if neg {
 						result = -result
 					}
@@ -70,6 +69,7 @@ func parseFloatGeneric(s string) (float64, error) {
 			}
 		}
 		// Fall through if can't handle it
+		stat(statFloatDirectMiss)
 	}
 
 	// TIER 2: Pattern classification and optimized simple parser (25-30% of inputs)
@@ -81,6 +81,7 @@ func parseFloatGeneric(s string) (float64, error) {
 		// Format: [-+]?[0-9]+\.?[0-9]*([eE][-+]?[0-9]+)?
 		result, mantissa, exp, neg, ok := parseSimpleFast(s)
 		if ok && exp >= minEiselLemireExp {
+			stat(statFloatSimple)
 			return result, nil
 		}
 
@@ -91,6 +92,7 @@ func parseFloatGeneric(s string) (float64, error) {
 				// This bypasses the FSA overhead (50-80ns savings) - matching strconv's approach
 				// Only do this if exp is in Eisel-Lemire's valid range
 				if result, ok := eisel_lemire.TryParse(mantissa, exp); ok {
+					stat(statFloatSimpleEiselLemire)
 					if neg {
 						result = -result
 					}
@@ -103,6 +105,9 @@ func parseFloatGeneric(s string) (float64, error) {
 			}
 		}
 		// Fall through to FSA if Eisel-Lemire also fails
+		stat(statFloatSimpleMiss)
+	} else {
+		stat(statFloatComplex)
 	}
 
 	// TIER 3: Complex pattern handling - comprehensive FSA path (5-10% of inputs)
@@ -116,6 +121,7 @@ func parseFloatGeneric(s string) (float64, error) {
 		}
 		if idx+1 < len(s) && s[idx] == '0' && (s[idx+1] == 'x' || s[idx+1] == 'X') {
 			if result, ok := parseHexFast(s); ok {
+				stat(statFloatHex)
 				return result, nil
 			}
 		}
@@ -125,29 +131,28 @@ func parseFloatGeneric(s string) (float64, error) {
 	// (pattern classifier rejects >24 chars as complex)
 	if len(s) >= 20 && len(s) <= 100 && !validation.HasComplexChars(s) {
 		if result, ok := parseLongDecimalFast(s); ok {
+			stat(statFloatLongDecimal)
 			return result, nil
 		}
 	}
 
 	// Full FSA path for all remaining cases
-	// Use pool to reduce allocations
-	pc := pcPool.Get().(*parsedComponents)
-	defer pcPool.Put(pc)
-	pc.reset()
-
-	err := parseComponents(s, pc)
+	var pc parsedComponents
+	err := parseComponents(s, &pc)
 	if err != nil {
 		return 0, err
 	}
 
 	if pc.special != specialNone {
-		return handleSpecial(pc)
+		stat(statFloatFSASpecial)
+		return handleSpecial(&pc)
 	}
 
 	if pc.isHex {
-		return convertHexFloat(pc)
+		stat(statFloatFSAHex)
+		return convertHexFloat(&pc)
 	}
-	return convertDecimalFloat(pc)
+	return convertDecimalFloat(&pc)
 }
 
 type specialKind int
@@ -163,65 +168,38 @@ type parsedComponents struct {
 	isHex    bool
 	special  specialKind
 
-	mantissa        uint64
-	mantDigits      []byte   // Slice view into mantDigitsArray or heap allocation
-	mantDigitsArray [32]byte // Stack array for common cases (≤32 digits)
-	mantDigitsLen   int      // Length of mantDigits
-	mantExp         int
-	exp             int64
-	expNeg          bool
-	digitCount      int
-	trailingZeros   int
-	hasMore         bool // For hex/decimal: are there more non-zero bits/digits beyond collected?
-	hexIntDigits    int  // Number of hex integer digits (before decimal point)
-	hexFracDigits   int  // Number of hex fractional digits (after decimal point)
-	totalFracDigits int  // Total fractional digits seen (including uncollected)
-}
-
-// reset prepares a parsedComponents for reuse
-func (pc *parsedComponents) reset() {
-	pc.negative = false
-	pc.isHex = false
-	pc.special = specialNone
-	pc.mantissa = 0
-	// Clear the array to prevent pollution between uses
-	for i := range pc.mantDigitsArray {
-		pc.mantDigitsArray[i] = 0
-	}
-	pc.mantDigits = pc.mantDigitsArray[:0] // Use stack array
-	pc.mantDigitsLen = 0
-	pc.mantExp = 0
-	pc.exp = 0
-	pc.expNeg = false
-	pc.digitCount = 0
-	pc.trailingZeros = 0
-	pc.hasMore = false
-	pc.hexIntDigits = 0
-	pc.hexFracDigits = 0
-	pc.totalFracDigits = 0
+	mantissa      uint64
+	mantDigits    [maxMantDigits]byte // significant digits, from the first nonzero
+	nd            int                 // number of digits used in mantDigits
+	mantExp       int
+	exp           int64
+	expNeg        bool
+	digitCount    int
+	trailingZeros int
+	hasMore       bool // For hex/decimal: are there more non-zero bits/digits beyond collected?
+	hexIntDigits  int  // Number of hex integer digits (before decimal point)
+	hexFracDigits int  // Number of hex fractional digits (after decimal point)
 }
 
 func parseComponents(s string, pc *parsedComponents) error {
-	// pc is already reset and has mantDigits pointing to mantDigitsArray
+	// pc is the zero value
 
 	var (
-		state                     fsa.State = fsa.StateStart
-		mantissa                  uint64    = 0
-		mantExp                   int       = 0
-		exp                       int64     = 0
-		expNeg                    bool      = false
-		negative                  bool      = false
-		isHex                     bool      = false
-		hasDigits                 bool      = false
-		hasHexDigits              bool      = false
-		inFraction                bool      = false
-		inHexFraction             bool      = false
-		digitCount                int       = 0
-		sawNonZero                bool      = false
-		significantDigits         int       = 0
-		trailingZeros             int       = 0
-		fractionalDigitsCollected int       = 0
-		maxFractionalDigits       int       = 300 // Limit for fractional digit collection (increased to handle long fractional strings)
+		state             fsa.State = fsa.StateStart
+		mantissa          uint64    = 0
+		mantExp           int       = 0
+		exp               int64     = 0
+		expNeg            bool      = false
+		negative          bool      = false
+		isHex             bool      = false
+		hasDigits         bool      = false
+		hasHexDigits      bool      = false
+		inFraction        bool      = false
+		inHexFraction     bool      = false
+		digitCount        int       = 0
+		sawNonZero        bool      = false
+		significantDigits int       = 0
+		trailingZeros     int       = 0
 	)
 
 	for i := 0; i < len(s); i++ {
@@ -305,14 +283,11 @@ func parseComponents(s string, pc *parsedComponents) error {
 				if digit != 0 || sawNonZero {
 					sawNonZero = true
 
-					// For fractional digits, limit collection to avoid pathological cases
+					// Collect digits until mantDigits is full
 					if inFraction {
-						pc.totalFracDigits++ // Track all fractional digits
-
-						// Check if we should still collect this digit
-						if fractionalDigitsCollected < maxFractionalDigits {
-							// Within fractional limit - collect the digit
-							pc.mantDigits = append(pc.mantDigits, ch)
+						if pc.nd < len(pc.mantDigits) {
+							pc.mantDigits[pc.nd] = ch
+							pc.nd++
 
 							if significantDigits < maxSignificantDigits {
 								if mantissa < (1<<63)/10 {
@@ -322,9 +297,8 @@ func parseComponents(s string, pc *parsedComponents) error {
 							}
 
 							mantExp--
-							fractionalDigitsCollected++
 						} else {
-							// Beyond fractional precision limit
+							// Beyond the digits kept
 							if digit != 0 {
 								pc.hasMore = true
 							}
@@ -332,8 +306,9 @@ func parseComponents(s string, pc *parsedComponents) error {
 						}
 					} else {
 						// Integer part
-						if len(pc.mantDigits) < 800 {
-							pc.mantDigits = append(pc.mantDigits, ch)
+						if pc.nd < len(pc.mantDigits) {
+							pc.mantDigits[pc.nd] = ch
+							pc.nd++
 
 							if significantDigits < maxSignificantDigits {
 								if mantissa < (1<<63)/10 {
@@ -342,9 +317,9 @@ func parseComponents(s string, pc *parsedComponents) error {
 								}
 							}
 							// Note: Don't increment mantExp for collected digits
-							// They're handled by big.Int conversion in convertDecimalFloat
+							// They're counted by nd in convertDecimalFloat
 						} else {
-							// Beyond integer limit (800 digits)
+							// Beyond the digits kept
 							// These digits are NOT collected, so increment mantExp
 							if digit != 0 {
 								pc.hasMore = true
@@ -379,8 +354,9 @@ func parseComponents(s string, pc *parsedComponents) error {
 			}
 
 			// Collect hex digits
-			if len(pc.mantDigits) < 20 {
-				pc.mantDigits = append(pc.mantDigits, ch)
+			if pc.nd < 20 {
+				pc.mantDigits[pc.nd] = ch
+				pc.nd++
 			} else if digit != 0 {
 				pc.hasMore = true
 			}
@@ -393,7 +369,7 @@ func parseComponents(s string, pc *parsedComponents) error {
 		case fsa.ActionHexPrefix:
 			isHex = true
 			mantissa = 0
-			pc.mantDigits = pc.mantDigits[:0]
+			pc.nd = 0
 			hasDigits = false
 			digitCount = 0
 
@@ -417,14 +393,13 @@ func parseComponents(s string, pc *parsedComponents) error {
 	// Validate final state
 	switch state {
 	case fsa.StateOK:
-		lower := toLowerASCII(s)
-		lower = trimSign(lower)
-		if lower == "inf" || lower == "infinity" {
+		rest := trimSign(s)
+		if equalFoldASCII(rest, "inf") || equalFoldASCII(rest, "infinity") {
 			pc.special = specialInf
 			pc.negative = negative
 			return nil
 		}
-		if lower == "nan" {
+		if equalFoldASCII(rest, "nan") {
 			pc.special = specialNaN
 			return nil
 		}
@@ -624,6 +599,7 @@ func convertDecimalFloat(pc *parsedComponents) (float64, error) {
 	}
 
 	if pc.mantissa == 0 {
+		stat(statFloatFSAShortcut)
 		if pc.negative {
 			return math.Copysign(0, -1), nil
 		}
@@ -632,15 +608,18 @@ func convertDecimalFloat(pc *parsedComponents) (float64, error) {
 
 	// Check for extreme exponents that would overflow/underflow
 	if totalExp >= 309 {
+		stat(statFloatFSAShortcut)
 		if pc.negative {
 			return math.Inf(-1), ErrRange
 		}
 		return math.Inf(1), ErrRange
 	}
 
-	// Check for extreme underflow
-	if totalExp < -324 {
-		// Way below minimum normal float64, underflows to zero
+	// Check for extreme underflow. The value is below 10^(nd+totalExp),
+	// as totalExp scales all nd digits kept.
+	if pc.nd+totalExp < -324 {
+		// Way below the smallest subnormal float64, underflows to zero
+		stat(statFloatFSAShortcut)
 		if pc.negative {
 			return math.Copysign(0, -1), nil
 		}
@@ -649,9 +628,10 @@ func convertDecimalFloat(pc *parsedComponents) (float64, error) {
 
 	// Try Eisel-Lemire fast path (based on Go's strconv implementation)
 	// Only call if totalExp is in Eisel-Lemire's valid range
-	if pc.mantissa != 0 && !pc.hasMore && len(pc.mantDigits) <= 19 && totalExp >= -348 && totalExp <= 308 {
+	if pc.mantissa != 0 && !pc.hasMore && pc.nd <= 19 && totalExp >= -348 && totalExp <= 308 {
 		if totalExp >= minEiselLemireExp {
 			if result, ok := eisel_lemire.TryParse(pc.mantissa, totalExp); ok {
+				stat(statFloatFSAEiselLemire)
 				if pc.negative {
 					result = -result
 				}
@@ -668,8 +648,9 @@ func convertDecimalFloat(pc *parsedComponents) (float64, error) {
 	// Handles cases where mantissa fits in 53 bits with moderate exponents
 	// Much faster than big.Float for these cases - now with assembly optimization
 	// Only use when mantissa contains all significant digits (≤19 digits collected)
-	if len(pc.mantDigits) <= 19 {
+	if pc.nd <= 19 {
 		if result, ok := conversion.ConvertDecimalExact(pc.mantissa, totalExp, pc.negative, float64pow10[:]); ok {
+			stat(statFloatFSAExact)
 			// Check for overflow or NaN
 			if math.IsInf(result, 0) || math.IsNaN(result) {
 				return result, ErrRange
@@ -680,6 +661,7 @@ func convertDecimalFloat(pc *parsedComponents) (float64, error) {
 		// Try extended conversion with math.Pow10 and rounding
 		// Handles long decimals (20-60 digits) faster than big.Float - now with assembly optimization
 		if result, ok := conversion.ConvertDecimalExtended(pc.mantissa, totalExp, pc.negative, float64pow10[:]); ok {
+			stat(statFloatFSAExtended)
 			// Check for overflow or NaN
 			if math.IsInf(result, 0) || math.IsNaN(result) {
 				return result, ErrRange
@@ -688,148 +670,21 @@ func convertDecimalFloat(pc *parsedComponents) (float64, error) {
 		}
 	}
 
-	// Limit mantissa digits to prevent performance issues
-	// But keep enough for precise rounding
-	mantDigits := pc.mantDigits
-	hadNonZeroTruncated := pc.hasMore
-
-	maxDigits := 200
-	if pc.hasMore {
-		maxDigits = 400 // Keep more digits if there are additional ones for better precision
+	// Fall back to multiprecision decimal arithmetic, exact in the digits
+	// kept, with the nonzero digits dropped beyond them noted by trunc.
+	// A decimal is a fixed-size value, so this allocates nothing.
+	stat(statFloatFSADecimal)
+	var d decimal
+	d.neg = pc.negative
+	d.nd = copy(d.d[:], pc.mantDigits[:pc.nd])
+	d.dp = d.nd + totalExp
+	d.trunc = pc.hasMore
+	trim(&d)
+	b, ovf := d.floatBits(&float64info)
+	if ovf {
+		return math.Float64frombits(b), ErrRange
 	}
-
-	if len(mantDigits) > maxDigits {
-		// Check if any truncated digits are non-zero
-		for i := maxDigits; i < len(mantDigits); i++ {
-			if mantDigits[i] != '0' {
-				hadNonZeroTruncated = true
-				break
-			}
-		}
-
-		// When truncating digits, we must adjust totalExp
-		// because totalExp is relative to the number of digits in the big.Int
-		digitsRemoved := len(mantDigits) - maxDigits
-		totalExp += digitsRemoved
-
-		mantDigits = mantDigits[:maxDigits]
-	}
-
-	// Build mantissa using big.Int for exact representation
-	mant := new(big.Int)
-	for _, d := range mantDigits {
-		if d >= '0' && d <= '9' {
-			mant.Mul(mant, big.NewInt(10))
-			mant.Add(mant, big.NewInt(int64(d-'0')))
-		}
-	}
-
-	if mant.Sign() == 0 {
-		if pc.negative {
-			return math.Copysign(0, -1), nil
-		}
-		return 0, nil
-	}
-
-	// Use big.Float with high precision for exact conversion
-	// Use extra precision if there are more digits or large exponents
-	prec := uint(256)
-	if pc.hasMore || len(mantDigits) > 50 {
-		prec = 1024 // Very high precision for complex cases
-	}
-	if pc.totalFracDigits > 1000 {
-		prec = 2048 // Even higher precision for very long fractional strings
-	}
-	f := new(big.Float).SetPrec(prec).SetInt(mant)
-
-	if totalExp != 0 {
-		pow10 := new(big.Float).SetPrec(prec)
-		absExp := totalExp
-		if absExp < 0 {
-			absExp = -absExp
-		}
-
-		// Build power of 10
-		if absExp < 512 {
-			pow10.SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(absExp)), nil))
-			if totalExp > 0 {
-				f.Mul(f, pow10)
-			} else {
-				f.Quo(f, pow10)
-			}
-		} else {
-			// Very extreme exponent
-			if totalExp > 0 {
-				if pc.negative {
-					return math.Inf(-1), ErrRange
-				}
-				return math.Inf(1), ErrRange
-			} else {
-				// Very large negative exponent
-				if pc.negative {
-					return math.Copysign(0, -1), nil
-				}
-				return 0, nil
-			}
-		}
-	}
-
-	// Check for overflow BEFORE converting to float64
-	// big.Float.Float64() rounds to nearest, but we want to detect overflow
-	maxF64 := new(big.Float).SetPrec(prec).SetFloat64(math.MaxFloat64)
-	if f.Cmp(maxF64) > 0 {
-		// Value exceeds max float64
-		if pc.negative {
-			return math.Inf(-1), ErrRange
-		}
-		return math.Inf(1), ErrRange
-	}
-
-	minF64 := new(big.Float).SetPrec(prec).SetFloat64(-math.MaxFloat64)
-	if f.Cmp(minF64) < 0 {
-		// Value below min float64
-		return math.Inf(-1), ErrRange
-	}
-
-	// Convert to float64 with correct rounding
-	result, acc := f.Float64()
-
-	// Double-check for overflow after conversion (should not happen now)
-	if math.IsInf(result, 0) {
-		// Any conversion to infinity is an overflow error
-		if pc.negative {
-			return math.Inf(-1), ErrRange
-		}
-		return math.Inf(1), ErrRange
-	}
-
-	// Handle tie-breaking when we have truncated non-zero digits
-	// Only apply for specific pathological cases
-	hasMoreDigits := hadNonZeroTruncated
-
-	if hasMoreDigits && result != 0 && acc == big.Below {
-		// big.Float rounded DOWN, but we have more non-zero digits
-		// This handles the "1.000...001" case where a distant digit should round up
-		//
-		// Only adjust if we have VERY MANY uncollected fractional digits
-		// This avoids incorrectly rounding repeating decimals like "2.222...222"
-		uncollectedFracDigits := pc.totalFracDigits - len(pc.mantDigits)
-		if uncollectedFracDigits > 5000 {
-			// Very long fractional part with distant trailing digit
-			if result > 0 {
-				result = math.Nextafter(result, math.Inf(1))
-			} else {
-				result = math.Nextafter(result, math.Inf(-1))
-			}
-		}
-	}
-	// Note: For most cases, trust big.Float's rounding
-
-	if pc.negative {
-		result = -result
-	}
-
-	return result, nil
+	return math.Float64frombits(b), nil
 }
 
 func convertDecimalSimple(mantissa uint64, exp10 int, negative bool) (float64, error) {
@@ -867,7 +722,7 @@ func convertDecimalSimple(mantissa uint64, exp10 int, negative bool) (float64, e
 }
 
 func convertHexFloat(pc *parsedComponents) (float64, error) {
-	if len(pc.mantDigits) == 0 {
+	if pc.nd == 0 {
 		if pc.negative {
 			return math.Copysign(0, -1), nil
 		}
@@ -877,7 +732,7 @@ func convertHexFloat(pc *parsedComponents) (float64, error) {
 	// Parse hex mantissa
 	// Simply parse all collected digits up to the limit
 	var mantissa uint64
-	digitsParsed := len(pc.mantDigits)
+	digitsParsed := pc.nd
 	if digitsParsed > 16 {
 		digitsParsed = 16
 	}
@@ -1075,16 +930,10 @@ func convertHexFloat(pc *parsedComponents) (float64, error) {
 	return math.Float64frombits(fbits), nil
 }
 
-func toLowerASCII(s string) string {
-	b := make([]byte, len(s))
-	for i := 0; i < len(s); i++ {
-		if s[i] >= 'A' && s[i] <= 'Z' {
-			b[i] = s[i] + 32
-		} else {
-			b[i] = s[i]
-		}
-	}
-	return string(b)
+// equalFoldASCII reports whether s equals the lower-case word under ASCII
+// case folding.
+func equalFoldASCII(s, word string) bool {
+	return len(s) == len(word) && commonPrefixLenIgnoreCase(s, word) == len(word)
 }
 
 func trimSign(s string) string {
@@ -2,8 +2,6 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build !amd64 && !arm64
-
 package fastparse
 
 import (
@@ -11,9 +9,9 @@ import (
 	"math/bits"
 )
 
-// ParseHexFast is the pure Go fallback for parseHexFast.
+// parseHexFastScalar is the pure Go version of parseHexFast.
 // Handles: [-]?0[xX][0-9a-fA-F]+\.?[0-9a-fA-F]*[pP][-+]?[0-9]+
-func parseHexFast(s string) (float64, bool) {
+func parseHexFastScalar(s string) (float64, bool) {
 	if len(s) < 5 {
 		return 0, false
 	}
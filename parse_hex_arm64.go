@@ -6,6 +6,8 @@
 
 package fastparse
 
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
 // parseHexFastAsm is the ARM64 assembly implementation of parseHexFast.
 // It parses hex floats: [-]?0[xX][0-9a-fA-F]+\.?[0-9a-fA-F]*[pP][-+]?[0-9]+
 // Returns (result, true) on success, (0, false) to fall back to pure Go.
@@ -15,5 +17,8 @@ func parseHexFastAsm(s string) (result float64, ok bool)
 
 // ParseHexFast dispatches to the assembly implementation.
 func parseHexFast(s string) (float64, bool) {
+	if !dispatch.Allow(dispatch.NEON) {
+		return parseHexFastScalar(s)
+	}
 	return parseHexFastAsm(s)
 }
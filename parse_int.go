@@ -68,14 +68,29 @@ const IntSize = intSize
 
 const maxUint64 = 1<<64 - 1
 
+const (
+	fnParseInt  = "ParseInt"
+	fnParseUint = "ParseUint"
+)
+
 // ParseUint is like [ParseInt] but for unsigned numbers.
 //
 // A sign prefix is not permitted.
 func ParseUint(s string, base int, bitSize int) (uint64, error) {
-	const fnParseUint = "ParseUint"
+	n, st := TryParseUint(s, base, bitSize)
+	if st != StatusOK {
+		return n, st.numError(fnParseUint, s, base, bitSize)
+	}
+	return n, nil
+}
 
+// TryParseUint is like [ParseUint] but reports failure as a [Status]
+// instead of an error, and so never allocates. The value returned with
+// each status is the one ParseUint returns with the matching error.
+func TryParseUint(s string, base int, bitSize int) (uint64, Status) {
 	if s == "" {
-		return 0, syntaxError(fnParseUint, s)
+		stat(statIntSyntax)
+		return 0, StatusSyntax
 	}
 
 	base0 := base == 0
@@ -86,33 +101,29 @@ func ParseUint(s string, base int, bitSize int) (uint64, error) {
 		// valid base; nothing to do
 
 	case base == 0:
-		// Look for octal, hex prefix.
-		base = 10
-		if s[0] == '0' {
-			switch {
-			case len(s) >= 3 && lower(s[1]) == 'b':
-				base = 2
-				s = s[2:]
-			case len(s) >= 3 && lower(s[1]) == 'o':
-				base = 8
-				s = s[2:]
-			case len(s) >= 3 && lower(s[1]) == 'x':
-				base = 16
-				s = s[2:]
-			default:
-				base = 8
-				s = s[1:]
-			}
-		}
+		base, s = basePrefix(s)
 
 	default:
-		return 0, baseError(fnParseUint, s0, base)
+		stat(statIntInvalidBase)
+		return 0, StatusInvalidBase
 	}
 
 	if bitSize == 0 {
 		bitSize = IntSize
 	} else if bitSize < 0 || bitSize > 64 {
-		return 0, bitSizeError(fnParseUint, s0, bitSize)
+		stat(statIntInvalidBitSize)
+		return 0, StatusInvalidBitSize
+	}
+
+	if statsEnabled {
+		switch {
+		case len(s) < len(s0):
+			stat(statIntPrefixed)
+		case base == 10:
+			stat(statIntDecimal)
+		default:
+			stat(statIntOtherBase)
+		}
 	}
 
 	// Cutoff is the smallest number such that cutoff*base > maxUint64.
@@ -142,32 +153,64 @@ func ParseUint(s string, base int, bitSize int) (uint64, error) {
 		case 'a' <= lower(c) && lower(c) <= 'z':
 			d = lower(c) - 'a' + 10
 		default:
-			return 0, syntaxError(fnParseUint, s0)
+			stat(statIntSyntax)
+			return 0, StatusSyntax
 		}
 
 		if d >= byte(base) {
-			return 0, syntaxError(fnParseUint, s0)
+			stat(statIntSyntax)
+			return 0, StatusSyntax
 		}
 
 		if n >= cutoff {
 			// n*base overflows
-			return maxVal, rangeError(fnParseUint, s0)
+			stat(statIntRange)
+			return maxVal, StatusRange
 		}
 		n *= uint64(base)
 
 		n1 := n + uint64(d)
 		if n1 < n || n1 > maxVal {
 			// n+d overflows
-			return maxVal, rangeError(fnParseUint, s0)
+			stat(statIntRange)
+			return maxVal, StatusRange
 		}
 		n = n1
 	}
 
-	if underscores && !underscoreOK(s0) {
-		return 0, syntaxError(fnParseUint, s0)
+	if underscores {
+		stat(statIntUnderscores)
+		if !underscoreOK(s0) {
+			stat(statIntSyntax)
+			return 0, StatusSyntax
+		}
 	}
 
-	return n, nil
+	return n, StatusOK
+}
+
+// TryParseUintBytes is like [TryParseUint] but parses a byte slice.
+func TryParseUintBytes(b []byte, base int, bitSize int) (uint64, Status) {
+	return TryParseUint(bytesToString(b), base, bitSize)
+}
+
+// basePrefix returns the base implied by the prefix of the nonempty
+// string s, as for base 0, and the digits following the prefix.
+func basePrefix(s string) (int, string) {
+	// Look for octal, hex prefix.
+	if s[0] == '0' {
+		switch {
+		case len(s) >= 3 && lower(s[1]) == 'b':
+			return 2, s[2:]
+		case len(s) >= 3 && lower(s[1]) == 'o':
+			return 8, s[2:]
+		case len(s) >= 3 && lower(s[1]) == 'x':
+			return 16, s[2:]
+		default:
+			return 8, s[1:]
+		}
+	}
+	return 10, s
 }
 
 // ParseInt interprets a string s in the given base (0, 2 to 36) and
@@ -196,14 +239,24 @@ func ParseUint(s string, base int, bitSize int) (uint64, error) {
 //
 // [integer literals]: https://go.dev/ref/spec#Integer_literals
 func ParseInt(s string, base int, bitSize int) (i int64, err error) {
-	const fnParseInt = "ParseInt"
+	i, st := TryParseInt(s, base, bitSize)
+	if st != StatusOK {
+		return i, st.numError(fnParseInt, s, base, bitSize)
+	}
+	return i, nil
+}
 
+// TryParseInt is like [ParseInt] but reports failure as a [Status]
+// instead of an error, and so never allocates. The value returned with
+// each status is the one ParseInt returns with the matching error:
+// with StatusRange, the maximum magnitude integer of the bitSize and sign.
+func TryParseInt(s string, base int, bitSize int) (int64, Status) {
 	if s == "" {
-		return 0, syntaxError(fnParseInt, s)
+		stat(statIntSyntax)
+		return 0, StatusSyntax
 	}
 
 	// Pick off leading sign.
-	s0 := s
 	neg := false
 	if s[0] == '+' {
 		s = s[1:]
@@ -213,30 +266,39 @@ func ParseInt(s string, base int, bitSize int) (i int64, err error) {
 	}
 
 	// Convert unsigned and check range.
-	var un uint64
-	un, err = ParseUint(s, base, bitSize)
-	if err != nil && err.(*NumError).Err != ErrRange {
-		// Reuse the NumError from ParseUint but change function name to ParseInt
-		nerr := err.(*NumError)
-		return 0, &NumError{fnParseInt, strings.Clone(s0), nerr.Err}
+	un, st := TryParseUint(s, base, bitSize)
+	if st != StatusOK && st != StatusRange {
+		return 0, st
 	}
 
 	if bitSize == 0 {
 		bitSize = IntSize
 	}
 
+	// A range error from ParseUint was counted there.
 	cutoff := uint64(1 << uint(bitSize-1))
 	if !neg && un >= cutoff {
-		return int64(cutoff - 1), rangeError(fnParseInt, s0)
+		if st == StatusOK {
+			stat(statIntRange)
+		}
+		return int64(cutoff - 1), StatusRange
 	}
 	if neg && un > cutoff {
-		return -int64(cutoff), rangeError(fnParseInt, s0)
+		if st == StatusOK {
+			stat(statIntRange)
+		}
+		return -int64(cutoff), StatusRange
 	}
 	n := int64(un)
 	if neg {
 		n = -n
 	}
-	return n, nil
+	return n, StatusOK
+}
+
+// TryParseIntBytes is like [TryParseInt] but parses a byte slice.
+func TryParseIntBytes(b []byte, base int, bitSize int) (int64, Status) {
+	return TryParseInt(bytesToString(b), base, bitSize)
 }
 
 // Atoi is equivalent to ParseInt(s, 10, 0), converted to type int.
@@ -251,6 +313,7 @@ func Atoi(s string) (int, error) {
 		if s[0] == '-' || s[0] == '+' {
 			s = s[1:]
 			if len(s) < 1 {
+				stat(statIntSyntax)
 				return 0, syntaxError(fnAtoi, s0)
 			}
 		}
@@ -259,6 +322,7 @@ func Atoi(s string) (int, error) {
 		for i := 0; i < len(s); i++ {
 			ch := s[i] - '0'
 			if ch > 9 {
+				stat(statIntSyntax)
 				return 0, syntaxError(fnAtoi, s0)
 			}
 			n = n*10 + int(ch)
@@ -266,17 +330,14 @@ func Atoi(s string) (int, error) {
 		if s0[0] == '-' {
 			n = -n
 		}
+		stat(statIntAtoiFast)
 		return n, nil
 	}
 
 	// Slow path for invalid, big, or underscored integers.
-	i64, err := ParseInt(s, 10, 0)
-	if err != nil {
-		if nerr, ok := err.(*NumError); ok {
-			// Reuse the NumError from ParseInt but change function name to Atoi
-			return int(i64), &NumError{fnAtoi, strings.Clone(nerr.Num), nerr.Err}
-		}
-		return int(i64), err
+	i64, st := TryParseInt(s, 10, 0)
+	if st != StatusOK {
+		return int(i64), st.numError(fnAtoi, s, 10, 0)
 	}
 	return int(i64), nil
 }
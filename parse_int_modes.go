@@ -0,0 +1,104 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+// The saturating and wrapping parsers differ from ParseInt and ParseUint
+// only for values out of range. They run TryParseUint, the kernel behind
+// ParseInt and ParseUint, and so are as fast; only an input it reports out
+// of range is scanned again by wrapUint, since TryParseUint stops at the
+// first digit that overflows.
+
+// ParseUintSaturating is like [ParseUint], but a value out of range for
+// bitSize is clamped to the largest unsigned integer of that size and
+// reported by clamped instead of by an [ErrRange] error. The input must
+// still be well-formed throughout, so "99999999999999999999x" is a syntax
+// error, as it is for ParseUint.
+func ParseUintSaturating(s string, base int, bitSize int) (v uint64, clamped bool, err error) {
+	v, st := TryParseUint(s, base, bitSize)
+	if st == StatusRange {
+		if _, ok := wrapUint(s, base); ok {
+			return v, true, nil
+		}
+		v, st = 0, StatusSyntax
+	}
+	if st != StatusOK {
+		return v, false, st.numError("ParseUintSaturating", s, base, bitSize)
+	}
+	return v, false, nil
+}
+
+// ParseIntSaturating is like [ParseInt], but a value out of range for
+// bitSize is clamped to the largest or smallest signed integer of that
+// size and reported by clamped instead of by an [ErrRange] error. The
+// input must still be well-formed throughout.
+func ParseIntSaturating(s string, base int, bitSize int) (v int64, clamped bool, err error) {
+	v, st := TryParseInt(s, base, bitSize)
+	if st == StatusRange {
+		if _, ok := wrapUint(trimSign(s), base); ok {
+			return v, true, nil
+		}
+		v, st = 0, StatusSyntax
+	}
+	if st != StatusOK {
+		return v, false, st.numError("ParseIntSaturating", s, base, bitSize)
+	}
+	return v, false, nil
+}
+
+// ParseUintWrapping is like [ParseUint], but a value out of range for
+// bitSize is reduced modulo 2^bitSize instead of being an [ErrRange]
+// error, as C's strtoull wraps on some systems. A sign prefix is not
+// permitted, and errors are otherwise those of ParseUint.
+func ParseUintWrapping(s string, base int, bitSize int) (uint64, error) {
+	v, st := TryParseUint(s, base, bitSize)
+	if st == StatusRange {
+		if n, ok := wrapUint(s, base); ok {
+			if bitSize == 0 {
+				bitSize = IntSize
+			}
+			return n & (uint64(1)<<uint(bitSize) - 1), nil
+		}
+		v, st = 0, StatusSyntax
+	}
+	if st != StatusOK {
+		return v, st.numError("ParseUintWrapping", s, base, bitSize)
+	}
+	return v, nil
+}
+
+// wrapUint parses s as TryParseUint does, given a valid base, but without
+// stopping at overflow: it returns the value of s modulo 2^64 and whether
+// s is well-formed.
+func wrapUint(s string, base int) (n uint64, ok bool) {
+	if s == "" {
+		return 0, false
+	}
+	s0 := s
+	base0 := base == 0
+	if base0 {
+		base, s = basePrefix(s)
+	}
+
+	underscores := false
+	for _, c := range []byte(s) {
+		var d byte
+		switch {
+		case c == '_' && base0:
+			underscores = true
+			continue
+		case '0' <= c && c <= '9':
+			d = c - '0'
+		case 'a' <= lower(c) && lower(c) <= 'z':
+			d = lower(c) - 'a' + 10
+		default:
+			return 0, false
+		}
+		if d >= byte(base) {
+			return 0, false
+		}
+		n = n*uint64(base) + uint64(d)
+	}
+	return n, !underscores || underscoreOK(s0)
+}
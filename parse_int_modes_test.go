@@ -0,0 +1,130 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"math"
+	"testing"
+)
+
+var parseUintSaturatingTests = []struct {
+	in            string
+	base, bitSize int
+	out           uint64
+	clamped       bool
+	err           error
+}{
+	{"0", 10, 64, 0, false, nil},
+	{"18446744073709551615", 10, 64, math.MaxUint64, false, nil},
+	{"18446744073709551616", 10, 64, math.MaxUint64, true, nil},
+	{"99999999999999999999999999", 10, 64, math.MaxUint64, true, nil},
+	{"256", 10, 8, math.MaxUint8, true, nil},
+	{"0x1_0000_0000", 0, 32, math.MaxUint32, true, nil},
+	{"zzzzzzzzzzzzzz", 36, 64, math.MaxUint64, true, nil},
+	{"99999999999999999999x", 10, 64, 0, false, ErrSyntax},
+	{"0x1_0000_0000_", 0, 32, 0, false, ErrSyntax},
+	{"1_0000000000000000000000", 10, 64, 0, false, ErrSyntax},
+	{"-1", 10, 64, 0, false, ErrSyntax},
+	{"", 10, 64, 0, false, ErrSyntax},
+}
+
+func TestParseUintSaturating(t *testing.T) {
+	for _, test := range parseUintSaturatingTests {
+		out, clamped, err := ParseUintSaturating(test.in, test.base, test.bitSize)
+		if out != test.out || clamped != test.clamped || extractInnerError(err) != test.err {
+			t.Errorf("ParseUintSaturating(%q, %d, %d) = %d, %t, %v want %d, %t, %v",
+				test.in, test.base, test.bitSize, out, clamped, err, test.out, test.clamped, test.err)
+		}
+	}
+	if _, _, err := ParseUintSaturating("1", 1, 64); !equalError(err, BaseError("ParseUintSaturating", "1", 1)) {
+		t.Errorf("ParseUintSaturating(%q, 1, 64): err = %v", "1", err)
+	}
+}
+
+var parseIntSaturatingTests = []struct {
+	in            string
+	base, bitSize int
+	out           int64
+	clamped       bool
+	err           error
+}{
+	{"-128", 10, 8, math.MinInt8, false, nil},
+	{"-129", 10, 8, math.MinInt8, true, nil},
+	{"128", 10, 8, math.MaxInt8, true, nil},
+	{"+9223372036854775808", 10, 64, math.MaxInt64, true, nil},
+	{"-9223372036854775809", 10, 64, math.MinInt64, true, nil},
+	{"-99999999999999999999999", 10, 64, math.MinInt64, true, nil},
+	{"-0x_8000_0001", 0, 32, math.MinInt32, true, nil},
+	{"-99999999999999999999999_", 0, 64, 0, false, ErrSyntax},
+	{"99999999999999999999999.5", 10, 64, 0, false, ErrSyntax},
+	{"--1", 10, 64, 0, false, ErrSyntax},
+}
+
+func TestParseIntSaturating(t *testing.T) {
+	for _, test := range parseIntSaturatingTests {
+		out, clamped, err := ParseIntSaturating(test.in, test.base, test.bitSize)
+		if out != test.out || clamped != test.clamped || extractInnerError(err) != test.err {
+			t.Errorf("ParseIntSaturating(%q, %d, %d) = %d, %t, %v want %d, %t, %v",
+				test.in, test.base, test.bitSize, out, clamped, err, test.out, test.clamped, test.err)
+		}
+	}
+	if _, _, err := ParseIntSaturating("1", 10, 65); !equalError(err, BitSizeError("ParseIntSaturating", "1", 65)) {
+		t.Errorf("ParseIntSaturating(%q, 10, 65): err = %v", "1", err)
+	}
+}
+
+var parseUintWrappingTests = []struct {
+	in            string
+	base, bitSize int
+	out           uint64
+	err           error
+}{
+	{"255", 10, 8, 255, nil},
+	{"256", 10, 8, 0, nil},
+	{"257", 10, 8, 1, nil},
+	{"65537", 10, 16, 1, nil},
+	{"18446744073709551616", 10, 64, 0, nil},
+	{"18446744073709551617", 10, 64, 1, nil},
+	{"36893488147419103237", 10, 64, 5, nil}, // 2^65 + 5
+	{"0x1_0000_0000_0000_0007", 0, 64, 7, nil},
+	{"0x1_0000_0007", 0, 32, 7, nil},
+	{"11111111111111111111111111111111111111111111111111111111111111111", 2, 64, math.MaxUint64, nil},
+	{"18446744073709551616!", 10, 64, 0, ErrSyntax},
+	{"+1", 10, 64, 0, ErrSyntax},
+}
+
+func TestParseUintWrapping(t *testing.T) {
+	for _, test := range parseUintWrappingTests {
+		out, err := ParseUintWrapping(test.in, test.base, test.bitSize)
+		if out != test.out || extractInnerError(err) != test.err {
+			t.Errorf("ParseUintWrapping(%q, %d, %d) = %d, %v want %d, %v",
+				test.in, test.base, test.bitSize, out, err, test.out, test.err)
+		}
+	}
+	// Below the range limit, every mode agrees with ParseUint.
+	for _, test := range parseUint64BaseTests {
+		if test.err == ErrRange {
+			continue
+		}
+		want, wantErr := ParseUint(test.in, test.base, 64)
+		got, err := ParseUintWrapping(test.in, test.base, 64)
+		sat, clamped, satErr := ParseUintSaturating(test.in, test.base, 64)
+		if got != want || sat != want || clamped || extractInnerError(err) != test.err || extractInnerError(satErr) != test.err {
+			t.Errorf("%q base %d: wrapping %d, %v; saturating %d, %t, %v; ParseUint %d, %v",
+				test.in, test.base, got, err, sat, clamped, satErr, want, wantErr)
+		}
+	}
+}
+
+func TestParseSaturatingAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		ParseUintSaturating("99999999999999999999999", 10, 64)
+		ParseIntSaturating("-0x_8000_0001", 0, 32)
+		ParseUintWrapping("18446744073709551617", 10, 64)
+	})
+	if allocs != 0 {
+		t.Errorf("clamping and wrapping: %v allocations, want 0", allocs)
+	}
+}
@@ -2,15 +2,13 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build !amd64 && !arm64
-
 package fastparse
 
 import "math"
 
-// ParseLongDecimalFast is the pure Go fallback for parseLongDecimalFast.
+// parseLongDecimalFastScalar is the pure Go version of parseLongDecimalFast.
 // Handles long decimals (20-100 digits) without FSA overhead.
-func parseLongDecimalFast(s string) (float64, bool) {
+func parseLongDecimalFastScalar(s string) (float64, bool) {
 	i := 0
 	negative := s[0] == '-'
 	if s[0] == '-' || s[0] == '+' {
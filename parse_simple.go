@@ -2,8 +2,6 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build !amd64 && !arm64
-
 package fastparse
 
 import (
@@ -12,12 +10,12 @@ import (
 	"github.com/mshafiee/fastparse/internal/eisel_lemire"
 )
 
-// parseSimpleFast is the pure Go fallback for non-optimized architectures.
+// parseSimpleFastScalar is the pure Go version of parseSimpleFast.
 // Returns (result, mantissa, exp, neg, true) on success.
 // Returns (0, mantissa, exp, neg, false) if parsed but can't convert (for Eisel-Lemire fallback).
 //
 // This allows parseFloatGeneric to call Eisel-Lemire directly without FSA overhead.
-func parseSimpleFast(s string) (float64, uint64, int, bool, bool) {
+func parseSimpleFastScalar(s string) (float64, uint64, int, bool, bool) {
 	if len(s) == 0 {
 		return 0, 0, 0, false, false
 	}
@@ -6,7 +6,11 @@
 
 package fastparse
 
-import "unsafe"
+import (
+	"unsafe"
+
+	"github.com/mshafiee/fastparse/internal/dispatch"
+)
 
 // parseSimpleFastAsm is the Go wrapper that prepares the raw pointer/length
 // arguments for the AMD64 assembly implementation.
@@ -26,5 +30,8 @@ func parseSimpleFastAsmRaw(ptr *byte, length int) (result float64, mantissa uint
 
 // parseSimpleFast dispatches to the assembly implementation.
 func parseSimpleFast(s string) (float64, uint64, int, bool, bool) {
+	if !dispatch.Allow(dispatch.SSE) {
+		return parseSimpleFastScalar(s)
+	}
 	return parseSimpleFastAsm(s)
 }
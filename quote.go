@@ -3,12 +3,12 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:generate go run makeisprint.go -output isprint.go
-
 package fastparse
 
 import (
 	"unicode/utf8"
+
+	"github.com/mshafiee/fastparse/internal/quoting"
 )
 
 const (
@@ -21,41 +21,10 @@ func contains(s string, c byte) bool {
 	return index(s, c) != -1
 }
 
-func quoteWith(s string, quote byte, ASCIIonly, graphicOnly bool) string {
-	return string(appendQuotedWith(make([]byte, 0, 3*len(s)/2), s, quote, ASCIIonly, graphicOnly))
-}
-
 func quoteRuneWith(r rune, quote byte, ASCIIonly, graphicOnly bool) string {
 	return string(appendQuotedRuneWith(nil, r, quote, ASCIIonly, graphicOnly))
 }
 
-func appendQuotedWith(buf []byte, s string, quote byte, ASCIIonly, graphicOnly bool) []byte {
-	// Often called with big strings, so preallocate. If there's quoting,
-	// this is conservative but still helps a lot.
-	if cap(buf)-len(buf) < len(s) {
-		nBuf := make([]byte, len(buf), len(buf)+1+len(s)+1)
-		copy(nBuf, buf)
-		buf = nBuf
-	}
-	buf = append(buf, quote)
-	for width := 0; len(s) > 0; s = s[width:] {
-		r := rune(s[0])
-		width = 1
-		if r >= utf8.RuneSelf {
-			r, width = utf8.DecodeRuneInString(s)
-		}
-		if width == 1 && r == utf8.RuneError {
-			buf = append(buf, `\x`...)
-			buf = append(buf, lowerhex[s[0]>>4])
-			buf = append(buf, lowerhex[s[0]&0xF])
-			continue
-		}
-		buf = appendEscapedRune(buf, r, quote, ASCIIonly, graphicOnly)
-	}
-	buf = append(buf, quote)
-	return buf
-}
-
 func appendQuotedRuneWith(buf []byte, r rune, quote byte, ASCIIonly, graphicOnly bool) []byte {
 	buf = append(buf, quote)
 	if !utf8.ValidRune(r) {
@@ -124,26 +93,26 @@ func appendEscapedRune(buf []byte, r rune, quote byte, ASCIIonly, graphicOnly bo
 // control characters and non-printable characters as defined by
 // [IsPrint].
 func Quote(s string) string {
-	return quoteWith(s, '"', false, false)
+	return goQuoter.Quote(s)
 }
 
 // AppendQuote appends a double-quoted Go string literal representing s,
 // as generated by [Quote], to dst and returns the extended buffer.
 func AppendQuote(dst []byte, s string) []byte {
-	return appendQuotedWith(dst, s, '"', false, false)
+	return goQuoter.Append(dst, s)
 }
 
 // QuoteToASCII returns a double-quoted Go string literal representing s.
 // The returned string uses Go escape sequences (\t, \n, \xFF, \u0100) for
 // non-ASCII characters and non-printable characters as defined by [IsPrint].
 func QuoteToASCII(s string) string {
-	return quoteToASCIIOptimized(s)
+	return asciiQuoter.Quote(s)
 }
 
 // AppendQuoteToASCII appends a double-quoted Go string literal representing s,
 // as generated by [QuoteToASCII], to dst and returns the extended buffer.
 func AppendQuoteToASCII(dst []byte, s string) []byte {
-	return appendQuoteToASCIIOptimized(dst, s)
+	return asciiQuoter.Append(dst, s)
 }
 
 // QuoteToGraphic returns a double-quoted Go string literal representing s.
@@ -151,13 +120,13 @@ func AppendQuoteToASCII(dst []byte, s string) []byte {
 // [IsGraphic], unchanged and uses Go escape sequences (\t, \n, \xFF, \u0100)
 // for non-graphic characters.
 func QuoteToGraphic(s string) string {
-	return quoteWith(s, '"', false, true)
+	return graphicQuoter.Quote(s)
 }
 
 // AppendQuoteToGraphic appends a double-quoted Go string literal representing s,
 // as generated by [QuoteToGraphic], to dst and returns the extended buffer.
 func AppendQuoteToGraphic(dst []byte, s string) []byte {
-	return appendQuotedWith(dst, s, '"', false, true)
+	return graphicQuoter.Append(dst, s)
 }
 
 // QuoteRune returns a single-quoted Go character literal representing the
@@ -395,6 +364,119 @@ func Unquote(s string) (string, error) {
 	return out, nil
 }
 
+// AppendUnquote appends the string value of the Go string literal s, as
+// understood by [Unquote], to dst and returns the extended buffer. On
+// error, dst is returned unchanged along with [ErrSyntax].
+func AppendUnquote(dst []byte, s string) ([]byte, error) {
+	n := len(dst)
+	dst, ok := appendUnquote(dst, s, false)
+	if !ok {
+		return dst[:n], ErrSyntax
+	}
+	return dst, nil
+}
+
+// UnquoteBytes interprets b as a Go string literal, as [Unquote] does, and
+// unescapes it in place, returning the value as a prefix of b. The value
+// always fits unless b contains invalid UTF-8, each byte of which becomes
+// a three-byte U+FFFD; then the value is returned in a new buffer. On
+// error, UnquoteBytes returns nil and [ErrSyntax], and the contents of b
+// are unspecified.
+func UnquoteBytes(b []byte) ([]byte, error) {
+	v, ok := appendUnquote(b[:0], bytesToString(b), true)
+	if !ok {
+		return nil, ErrSyntax
+	}
+	return v, nil
+}
+
+// appendUnquote appends the value of the Go string literal s to dst. Runs
+// of plain characters are found with SIMD and copied as is. If inPlace is
+// set, dst shares its first byte with s: the value is written behind the
+// unread input, and moved to a new buffer before a U+FFFD replacing an
+// invalid byte could overtake it.
+func appendUnquote(dst []byte, s string, inPlace bool) ([]byte, bool) {
+	if len(s) < 2 || s[len(s)-1] != s[0] {
+		return dst, false
+	}
+	quote := s[0]
+	body := s[1 : len(s)-1]
+	switch quote {
+	case '`':
+		if contains(body, '`') {
+			return dst, false
+		}
+		// Carriage returns are discarded from raw strings.
+		for i := index(body, '\r'); i >= 0; i = index(body, '\r') {
+			dst = append(dst, body[:i]...)
+			body = body[i+1:]
+		}
+		return append(dst, body...), true
+	case '"', '\'':
+	default:
+		return dst, false
+	}
+
+	for len(body) > 0 {
+		if quote == '"' {
+			i := quoting.UnquoteSpecial.Index(body)
+			if i < 0 {
+				i = len(body)
+			}
+			if i > 0 {
+				run, start := body[:i], len(s)-1-len(body)
+				body = body[i:]
+				if utf8.ValidString(run) {
+					dst = append(dst, run...)
+					continue
+				}
+				for j := 0; j < len(run); {
+					r, n := utf8.DecodeRuneInString(run[j:])
+					j += n
+					if r == utf8.RuneError && n == 1 {
+						dst, inPlace = appendInvalid(dst, inPlace, start+j, len(s)-1)
+					} else {
+						dst = append(dst, run[j-n:j]...)
+					}
+				}
+				continue
+			}
+		}
+		if body[0] == '\n' {
+			return dst, false
+		}
+		r, multibyte, rem, err := UnquoteChar(body, quote)
+		if err != nil {
+			return dst, false
+		}
+		switch {
+		case r < utf8.RuneSelf || !multibyte:
+			dst = append(dst, byte(r))
+		case r == utf8.RuneError && len(body)-len(rem) == 1:
+			dst, inPlace = appendInvalid(dst, inPlace, len(s)-1-len(rem), len(s)-1)
+		default:
+			dst = utf8.AppendRune(dst, r)
+		}
+		body = rem
+		if quote == '\'' && len(body) > 0 {
+			return dst, false // more than one character
+		}
+	}
+	return dst, true
+}
+
+// appendInvalid appends U+FFFD in place of an invalid byte for
+// appendUnquote. The input that is still unread lies at [unread, end) of
+// s; if dst is in place and the rune would overwrite it, dst is moved to a
+// new buffer first.
+func appendInvalid(dst []byte, inPlace bool, unread, end int) ([]byte, bool) {
+	if inPlace && len(dst)+len(string(utf8.RuneError)) > unread && unread < end {
+		dst = append([]byte(nil), dst...)
+		inPlace = false
+	}
+	return utf8.AppendRune(dst, utf8.RuneError), inPlace
+}
+
 // unquote parses a quoted string at the start of the input,
 // returning the parsed prefix, the remaining suffix, and any parse errors.
 // If unescape is true, the parsed prefix is unescaped,
@@ -541,321 +623,10 @@ func IsGraphic(r rune) bool {
 // from IsGraphic allows quoteWith to avoid two calls to IsPrint.
 // Should be called only if IsPrint fails.
 func isInGraphicList(r rune) bool {
-	// We know r must fit in 16 bits - see makeisprint.go.
+	// We know r must fit in 16 bits - see internal/unicode_tables/makeisprint.go.
 	if r > 0xFFFF {
 		return false
 	}
 	_, found := bsearch(isGraphic, uint16(r))
 	return found
 }
-
-// quoteToASCIIOptimized is an optimized version of QuoteToASCII
-func quoteToASCIIOptimized(s string) string {
-	// Fast path: pure ASCII with no special characters
-	needsEscape := false
-	for i := 0; i < len(s); i++ {
-		c := s[i]
-		if c >= 0x80 || c == '"' || c == '\\' || c < ' ' || c == 0x7F {
-			needsEscape = true
-			break
-		}
-	}
-
-	if !needsEscape {
-		// Simple case: just add quotes
-		return `"` + s + `"`
-	}
-
-	// Calculate exact size needed
-	size := 2 // opening and closing quotes
-	for i := 0; i < len(s); i++ {
-		c := s[i]
-		if c >= 0x80 {
-			// Non-ASCII: \uHHHH or \UHHHHHHHH
-			if c < 0xC0 || i+1 >= len(s) {
-				size += 6 // \uHHHH for invalid or single byte
-			} else {
-				// Decode UTF-8 to determine size
-				_, width := utf8.DecodeRuneInString(s[i:])
-				r, _ := utf8.DecodeRuneInString(s[i:])
-				if r <= 0xFFFF {
-					size += 6 // \uHHHH
-				} else {
-					size += 10 // \UHHHHHHHH
-				}
-				i += width - 1
-			}
-		} else if c == '"' || c == '\\' {
-			size += 2 // \q or \\
-		} else if c >= ' ' && c != 0x7F {
-			size += 1 // regular ASCII
-		} else {
-			// Control character
-			switch c {
-			case '\a', '\b', '\f', '\n', '\r', '\t', '\v':
-				size += 2 // \a, \b, etc.
-			default:
-				size += 4 // \xHH
-			}
-		}
-	}
-
-	// Build result
-	buf := make([]byte, size)
-	buf[0] = '"'
-	pos := 1
-
-	for i := 0; i < len(s); {
-		c := s[i]
-
-		if c >= 0x80 {
-			// Handle UTF-8
-			r, width := utf8.DecodeRuneInString(s[i:])
-			if r == utf8.RuneError && width == 1 {
-				// Invalid UTF-8
-				buf[pos] = '\\'
-				buf[pos+1] = 'x'
-				buf[pos+2] = lowerhex[c>>4]
-				buf[pos+3] = lowerhex[c&0xF]
-				pos += 4
-			} else if r <= 0xFFFF {
-				// \uHHHH
-				buf[pos] = '\\'
-				buf[pos+1] = 'u'
-				buf[pos+2] = lowerhex[r>>12]
-				buf[pos+3] = lowerhex[(r>>8)&0xF]
-				buf[pos+4] = lowerhex[(r>>4)&0xF]
-				buf[pos+5] = lowerhex[r&0xF]
-				pos += 6
-			} else {
-				// \UHHHHHHHH
-				buf[pos] = '\\'
-				buf[pos+1] = 'U'
-				buf[pos+2] = lowerhex[r>>28]
-				buf[pos+3] = lowerhex[(r>>24)&0xF]
-				buf[pos+4] = lowerhex[(r>>20)&0xF]
-				buf[pos+5] = lowerhex[(r>>16)&0xF]
-				buf[pos+6] = lowerhex[(r>>12)&0xF]
-				buf[pos+7] = lowerhex[(r>>8)&0xF]
-				buf[pos+8] = lowerhex[(r>>4)&0xF]
-				buf[pos+9] = lowerhex[r&0xF]
-				pos += 10
-			}
-			i += width
-		} else if c == '"' {
-			buf[pos] = '\\'
-			buf[pos+1] = '"'
-			pos += 2
-			i++
-		} else if c == '\\' {
-			buf[pos] = '\\'
-			buf[pos+1] = '\\'
-			pos += 2
-			i++
-		} else if c >= ' ' && c != 0x7F {
-			buf[pos] = c
-			pos++
-			i++
-		} else {
-			// Control character
-			switch c {
-			case '\a':
-				buf[pos] = '\\'
-				buf[pos+1] = 'a'
-				pos += 2
-			case '\b':
-				buf[pos] = '\\'
-				buf[pos+1] = 'b'
-				pos += 2
-			case '\f':
-				buf[pos] = '\\'
-				buf[pos+1] = 'f'
-				pos += 2
-			case '\n':
-				buf[pos] = '\\'
-				buf[pos+1] = 'n'
-				pos += 2
-			case '\r':
-				buf[pos] = '\\'
-				buf[pos+1] = 'r'
-				pos += 2
-			case '\t':
-				buf[pos] = '\\'
-				buf[pos+1] = 't'
-				pos += 2
-			case '\v':
-				buf[pos] = '\\'
-				buf[pos+1] = 'v'
-				pos += 2
-			default:
-				buf[pos] = '\\'
-				buf[pos+1] = 'x'
-				buf[pos+2] = lowerhex[c>>4]
-				buf[pos+3] = lowerhex[c&0xF]
-				pos += 4
-			}
-			i++
-		}
-	}
-
-	buf[pos] = '"'
-	return string(buf[:pos+1])
-}
-
-// appendQuoteToASCIIOptimized is an optimized version of AppendQuoteToASCII
-func appendQuoteToASCIIOptimized(dst []byte, s string) []byte {
-	// Fast path: pure ASCII with no special characters
-	needsEscape := false
-	for i := 0; i < len(s); i++ {
-		c := s[i]
-		if c >= 0x80 || c == '"' || c == '\\' || c < ' ' || c == 0x7F {
-			needsEscape = true
-			break
-		}
-	}
-
-	if !needsEscape {
-		// Simple case: just add quotes
-		dst = append(dst, '"')
-		dst = append(dst, s...)
-		dst = append(dst, '"')
-		return dst
-	}
-
-	// Calculate exact size needed
-	size := 2 // opening and closing quotes
-	for i := 0; i < len(s); i++ {
-		c := s[i]
-		if c >= 0x80 {
-			// Non-ASCII: \uHHHH or \UHHHHHHHH
-			if c < 0xC0 || i+1 >= len(s) {
-				size += 6 // \uHHHH for invalid or single byte
-			} else {
-				// Decode UTF-8 to determine size
-				_, width := utf8.DecodeRuneInString(s[i:])
-				r, _ := utf8.DecodeRuneInString(s[i:])
-				if r <= 0xFFFF {
-					size += 6 // \uHHHH
-				} else {
-					size += 10 // \UHHHHHHHH
-				}
-				i += width - 1
-			}
-		} else if c == '"' || c == '\\' {
-			size += 2 // \q or \\
-		} else if c >= ' ' && c != 0x7F {
-			size += 1 // regular ASCII
-		} else {
-			// Control character
-			switch c {
-			case '\a', '\b', '\f', '\n', '\r', '\t', '\v':
-				size += 2 // \a, \b, etc.
-			default:
-				size += 4 // \xHH
-			}
-		}
-	}
-
-	// Grow buffer
-	oldLen := len(dst)
-	dst = append(dst, make([]byte, size)...)
-
-	dst[oldLen] = '"'
-	pos := oldLen + 1
-
-	for i := 0; i < len(s); {
-		c := s[i]
-
-		if c >= 0x80 {
-			// Handle UTF-8
-			r, width := utf8.DecodeRuneInString(s[i:])
-			if r == utf8.RuneError && width == 1 {
-				// Invalid UTF-8
-				dst[pos] = '\\'
-				dst[pos+1] = 'x'
-				dst[pos+2] = lowerhex[c>>4]
-				dst[pos+3] = lowerhex[c&0xF]
-				pos += 4
-			} else if r <= 0xFFFF {
-				// \uHHHH
-				dst[pos] = '\\'
-				dst[pos+1] = 'u'
-				dst[pos+2] = lowerhex[r>>12]
-				dst[pos+3] = lowerhex[(r>>8)&0xF]
-				dst[pos+4] = lowerhex[(r>>4)&0xF]
-				dst[pos+5] = lowerhex[r&0xF]
-				pos += 6
-			} else {
-				// \UHHHHHHHH
-				dst[pos] = '\\'
-				dst[pos+1] = 'U'
-				dst[pos+2] = lowerhex[r>>28]
-				dst[pos+3] = lowerhex[(r>>24)&0xF]
-				dst[pos+4] = lowerhex[(r>>20)&0xF]
-				dst[pos+5] = lowerhex[(r>>16)&0xF]
-				dst[pos+6] = lowerhex[(r>>12)&0xF]
-				dst[pos+7] = lowerhex[(r>>8)&0xF]
-				dst[pos+8] = lowerhex[(r>>4)&0xF]
-				dst[pos+9] = lowerhex[r&0xF]
-				pos += 10
-			}
-			i += width
-		} else if c == '"' {
-			dst[pos] = '\\'
-			dst[pos+1] = '"'
-			pos += 2
-			i++
-		} else if c == '\\' {
-			dst[pos] = '\\'
-			dst[pos+1] = '\\'
-			pos += 2
-			i++
-		} else if c >= ' ' && c != 0x7F {
-			dst[pos] = c
-			pos++
-			i++
-		} else {
-			// Control character
-			switch c {
-			case '\a':
-				dst[pos] = '\\'
-				dst[pos+1] = 'a'
-				pos += 2
-			case '\b':
-				dst[pos] = '\\'
-				dst[pos+1] = 'b'
-				pos += 2
-			case '\f':
-				dst[pos] = '\\'
-				dst[pos+1] = 'f'
-				pos += 2
-			case '\n':
-				dst[pos] = '\\'
-				dst[pos+1] = 'n'
-				pos += 2
-			case '\r':
-				dst[pos] = '\\'
-				dst[pos+1] = 'r'
-				pos += 2
-			case '\t':
-				dst[pos] = '\\'
-				dst[pos+1] = 't'
-				pos += 2
-			case '\v':
-				dst[pos] = '\\'
-				dst[pos+1] = 'v'
-				pos += 2
-			default:
-				dst[pos] = '\\'
-				dst[pos+1] = 'x'
-				dst[pos+2] = lowerhex[c>>4]
-				dst[pos+3] = lowerhex[c&0xF]
-				pos += 4
-			}
-			i++
-		}
-	}
-
-	dst[pos] = '"'
-	return dst[:pos+1]
-}
@@ -0,0 +1,60 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import "github.com/mshafiee/fastparse/internal/quoting"
+
+// JSONFlags controls how [AppendQuoteJSON] escapes a string.
+type JSONFlags uint8
+
+const (
+	// JSONEscapeHTML escapes <, > and & as \u003c, \u003e and \u0026,
+	// as json.Marshal does by default.
+	JSONEscapeHTML JSONFlags = 1 << iota
+
+	// JSONASCIIOnly escapes every non-ASCII character as \uXXXX, using a
+	// UTF-16 surrogate pair for characters above U+FFFF.
+	JSONASCIIOnly
+)
+
+// QuoteJSON returns a double-quoted JSON string literal representing s.
+// See [AppendQuoteJSON].
+func QuoteJSON(s string, flags JSONFlags) string {
+	return string(AppendQuoteJSON(make([]byte, 0, len(s)+2), s, flags))
+}
+
+// AppendQuoteJSON appends a double-quoted JSON string literal representing
+// s, as generated by encoding/json, to dst and returns the extended buffer.
+//
+// Unlike [AppendQuote], it never emits Go-only escapes such as \x or \a.
+// Each invalid UTF-8 byte is replaced by U+FFFD, and U+2028 and U+2029 are
+// always escaped. Without flags the output matches json.Encoder with
+// SetEscapeHTML(false); with [JSONEscapeHTML] it matches json.Marshal.
+func AppendQuoteJSON(dst []byte, s string, flags JSONFlags) []byte {
+	return quoting.AppendJSON(dst, s, flags&JSONEscapeHTML != 0, flags&JSONASCIIOnly != 0)
+}
+
+// UnquoteJSON interprets s as a double-quoted JSON string literal,
+// returning the string value that s quotes. Invalid UTF-8 and unpaired
+// surrogate escapes are replaced by U+FFFD, as json.Unmarshal does.
+// If s is not a valid JSON string literal, UnquoteJSON returns [ErrSyntax].
+func UnquoteJSON(s string) (string, error) {
+	b, err := AppendUnquoteJSON(nil, s)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// AppendUnquoteJSON appends the string value of the JSON string literal s
+// to dst and returns the extended buffer. On error, dst is returned
+// unchanged along with [ErrSyntax].
+func AppendUnquoteJSON(dst []byte, s string) ([]byte, error) {
+	dst, ok := quoting.AppendUnquoteJSON(dst, s)
+	if !ok {
+		return dst, ErrSyntax
+	}
+	return dst, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/mshafiee/fastparse"
+)
+
+var quoteJSONTests = []string{
+	"",
+	"hello",
+	"Hello, World!",
+	`quote " and backslash \`,
+	"\x00\x01\x07\b\f\n\r\t\v\x1b\x1f\x7f",
+	"<script>alert('x')</script> & more",
+	"unicode: 世界 🚀 ☺",
+	"separators: \u2028 \u2029",
+	"invalid: \xff \xc3\x28 \xed\xa0\x80 \xf0\x9f\x98",
+	"\ufffd",
+	strings.Repeat("abcdefghijklmnopqrstuvwxyz0123456789", 8),
+	strings.Repeat("x", 63) + "\"" + strings.Repeat("y", 70),
+	strings.Repeat("é", 40),
+}
+
+// jsonEncode returns s as encoding/json writes it.
+func jsonEncode(t testing.TB, s string, html bool) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(html)
+	if err := enc.Encode(s); err != nil {
+		t.Fatal(err)
+	}
+	// Older encoders escape invalid UTF-8 as \ufffd rather than writing
+	// U+FFFD itself.
+	return strings.ReplaceAll(strings.TrimSuffix(buf.String(), "\n"), `\ufffd`, "\uFFFD")
+}
+
+func randomJSONString(r *rand.Rand) string {
+	pieces := []string{
+		"<", ">", "&", "\"", "\\", "/", "\x00", "\n", "\t", "\x7f", " ",
+		"\u00e9", "\u4e16", "\U0001F680", "\u2028", "\xff", "\xed\xa0\x80", "\xe4\x9a",
+	}
+	n := r.Intn(100)
+	var b []byte
+	for i := 0; i < n; i++ {
+		if r.Intn(4) == 0 {
+			b = append(b, pieces[r.Intn(len(pieces))]...)
+		} else {
+			b = append(b, byte('a'+r.Intn(26)))
+		}
+	}
+	return string(b)
+}
+
+func TestAppendQuoteJSON(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tests := quoteJSONTests
+	for i := 0; i < 2000; i++ {
+		tests = append(tests, randomJSONString(r))
+	}
+	for _, s := range tests {
+		for _, html := range []bool{false, true} {
+			var flags fastparse.JSONFlags
+			if html {
+				flags = fastparse.JSONEscapeHTML
+			}
+			want := jsonEncode(t, s, html)
+			if got := string(fastparse.AppendQuoteJSON([]byte("x"), s, flags)); got != "x"+want {
+				t.Errorf("AppendQuoteJSON(%q, %v) = %s, want x%s", s, flags, got, want)
+			}
+			if got := fastparse.QuoteJSON(s, flags); got != want {
+				t.Errorf("QuoteJSON(%q, %v) = %s, want %s", s, flags, got, want)
+			}
+		}
+	}
+}
+
+func TestAppendQuoteJSONASCII(t *testing.T) {
+	tests := []struct {
+		in, out string
+	}{
+		{"abc", `"abc"`},
+		{"é", `"\u00e9"`},
+		{"世界", `"\u4e16\u754c"`},
+		{"🚀", `"\ud83d\ude80"`},
+		{"\u2028", `"\u2028"`},
+		{"\xff", `"\ufffd"`},
+		{"<&>", `"\u003c\u0026\u003e"`},
+		{"\x7f\x00", "\"\x7f\\u0000\""},
+	}
+	for _, tt := range tests {
+		flags := fastparse.JSONASCIIOnly | fastparse.JSONEscapeHTML
+		if got := fastparse.QuoteJSON(tt.in, flags); got != tt.out {
+			t.Errorf("QuoteJSON(%q, ASCII) = %s, want %s", tt.in, got, tt.out)
+		}
+	}
+	for _, s := range quoteJSONTests {
+		q := fastparse.QuoteJSON(s, fastparse.JSONASCIIOnly)
+		for i := 0; i < len(q); i++ {
+			if q[i] >= utf8.RuneSelf {
+				t.Errorf("QuoteJSON(%q, ASCII) = %s contains non-ASCII", s, q)
+				break
+			}
+		}
+		var want, got string
+		if err := json.Unmarshal([]byte(jsonEncode(t, s, false)), &want); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal([]byte(q), &got); err != nil || got != want {
+			t.Errorf("QuoteJSON(%q, ASCII) = %s decodes to %q, %v; want %q", s, q, got, err, want)
+		}
+	}
+}
+
+func TestUnquoteJSON(t *testing.T) {
+	tests := []string{
+		`""`,
+		`"hello"`,
+		`"a\"b\\c\/d\be\ff\ng\rh\ti"`,
+		`"\u0041\u00e9\u4e16"`,
+		`"\ud83d\ude80"`,
+		`"\uD83D\uDE80"`,
+		`"\ud83d"`,
+		`"\ude80\ud83d"`,
+		`"\ud83dx"`,
+		`"\ud83dA"`,
+		`"\ud83d\uZZZZ"`,
+		`"\u12"`,
+		`"\x41"`,
+		`"\'"`,
+		`"\a"`,
+		`"a"b"`,
+		"\"tab\there\"",
+		"\"\x7f\"",
+		"\"\xff\xfe\"",
+		"\"世界\"",
+		`"trailing\"`,
+		`"`,
+		`'a'`,
+		`abc`,
+		``,
+	}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		s := fastparse.QuoteJSON(randomJSONString(r), fastparse.JSONFlags(r.Intn(4)))
+		tests = append(tests, s)
+		if len(s) > 2 {
+			// Corrupt one byte to exercise the error paths.
+			b := []byte(s)
+			b[1+r.Intn(len(b)-2)] = `\"u0`[r.Intn(4)]
+			tests = append(tests, string(b))
+		}
+	}
+	for _, s := range tests {
+		var want string
+		wantErr := json.Unmarshal([]byte(s), &want)
+		got, err := fastparse.UnquoteJSON(s)
+		if (err != nil) != (wantErr != nil) || got != want {
+			t.Errorf("UnquoteJSON(%#q) = %q, %v; want %q, %v", s, got, err, want, wantErr)
+		}
+		buf, err := fastparse.AppendUnquoteJSON([]byte("x"), s)
+		if err != nil {
+			if string(buf) != "x" {
+				t.Errorf("AppendUnquoteJSON(x, %#q) = %q on error, want x", s, buf)
+			}
+		} else if string(buf) != "x"+want {
+			t.Errorf("AppendUnquoteJSON(x, %#q) = %q, want x%q", s, buf, want)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"strings"
+
+	"github.com/mshafiee/fastparse/internal/quoting"
+)
+
+// QuoteShell returns s quoted as a single word for a POSIX shell.
+// See [AppendQuoteShell].
+func QuoteShell(s string) string {
+	return string(AppendQuoteShell(make([]byte, 0, len(s)+2), s))
+}
+
+// AppendQuoteShell appends s, quoted as a single word for a POSIX shell,
+// to dst and returns the extended buffer.
+//
+// The result is the simplest safe form: s itself if it consists
+// only of ASCII letters, digits and @%+=:,./-_; a pair of single quotes if
+// s is empty; and otherwise s in single quotes, where each single quote in
+// s closes the quoted run, is written as \', and reopens it:
+//
+//	hello world  =>  'hello world'
+//	it's         =>  'it'\''s'
+//
+// Unlike [Quote], the result is valid sh(1) syntax.
+func AppendQuoteShell(dst []byte, s string) []byte {
+	return quoting.AppendShell(dst, s)
+}
+
+// A ShellSyntaxError reports a malformed word list passed to
+// [SplitShellWords].
+type ShellSyntaxError struct {
+	Offset int    // byte offset of the offending character in the input
+	Msg    string // description of the problem
+}
+
+func (e *ShellSyntaxError) Error() string {
+	return "fastparse.SplitShellWords: " + e.Msg + " at offset " + Itoa(e.Offset)
+}
+
+func (e *ShellSyntaxError) Unwrap() error { return ErrSyntax }
+
+// SplitShellWords splits s into words the way a POSIX shell does,
+// applying its quote removal: single quotes preserve everything up to the
+// next single quote, double quotes preserve everything except that a
+// backslash escapes $, `, ", \ and newline, and an unquoted backslash
+// escapes any character. Backslash-newline is removed outside single
+// quotes. Words are separated by unquoted spaces, tabs and newlines.
+//
+// No expansions, globbing or comments are processed, so $, *, # and
+// operators such as | and ; are ordinary characters. An unterminated
+// quote or a trailing backslash is reported as a *[ShellSyntaxError].
+func SplitShellWords(s string) ([]string, error) {
+	var words []string
+	var buf []byte
+	i := 0
+	for {
+		for i < len(s) && isShellBlank(s[i]) {
+			i++
+		}
+		if i == len(s) {
+			return words, nil
+		}
+		buf = buf[:0]
+		started := false // whether a word began, possibly an empty one
+	word:
+		for i < len(s) {
+			if j := quoting.ShellSpecial.Index(s[i:]); j != 0 {
+				if j < 0 {
+					j = len(s) - i
+				}
+				buf = append(buf, s[i:i+j]...)
+				started = true
+				i += j
+				continue
+			}
+			switch s[i] {
+			case ' ', '\t', '\n':
+				break word
+			case '\\':
+				if i+1 == len(s) {
+					return nil, &ShellSyntaxError{i, "trailing backslash"}
+				}
+				if s[i+1] != '\n' {
+					buf = append(buf, s[i+1])
+					started = true
+				}
+				i += 2
+			case '\'':
+				j := strings.IndexByte(s[i+1:], '\'')
+				if j < 0 {
+					return nil, &ShellSyntaxError{i, "unterminated single quote"}
+				}
+				buf = append(buf, s[i+1:i+1+j]...)
+				started = true
+				i += j + 2
+			case '"':
+				open := i
+				for i++; ; {
+					j := strings.IndexAny(s[i:], `"\`)
+					if j < 0 {
+						return nil, &ShellSyntaxError{open, "unterminated double quote"}
+					}
+					buf = append(buf, s[i:i+j]...)
+					i += j
+					if s[i] == '"' {
+						i++
+						break
+					}
+					if i+1 == len(s) {
+						return nil, &ShellSyntaxError{open, "unterminated double quote"}
+					}
+					switch s[i+1] {
+					case '$', '`', '"', '\\':
+						buf = append(buf, s[i+1])
+					case '\n':
+					default:
+						buf = append(buf, '\\', s[i+1])
+					}
+					i += 2
+				}
+				started = true
+			}
+		}
+		if started {
+			words = append(words, string(buf))
+		}
+	}
+}
+
+func isShellBlank(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
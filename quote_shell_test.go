@@ -0,0 +1,141 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse_test
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mshafiee/fastparse"
+)
+
+var quoteShellTests = []struct {
+	in, out string
+}{
+	{"", "''"},
+	{"abc", "abc"},
+	{"/usr/local/bin/go", "/usr/local/bin/go"},
+	{"--flag=a,b:c@d%e+f", "--flag=a,b:c@d%e+f"},
+	{"hello world", "'hello world'"},
+	{"it's", `'it'\''s'`},
+	{"'", `\'`},
+	{"''", `\'\'`},
+	{"'quoted'", `\''quoted'\'`},
+	{"$HOME", "'$HOME'"},
+	{"a\nb", "'a\nb'"},
+	{"~user", "'~user'"},
+	{"*.go", "'*.go'"},
+	{"a;b|c&d", "'a;b|c&d'"},
+	{"é", "'é'"},
+	{strings.Repeat("x", 40) + " " + strings.Repeat("y", 40), "'" + strings.Repeat("x", 40) + " " + strings.Repeat("y", 40) + "'"},
+	{strings.Repeat("safe", 20), strings.Repeat("safe", 20)},
+}
+
+func TestQuoteShell(t *testing.T) {
+	for _, tt := range quoteShellTests {
+		if got := fastparse.QuoteShell(tt.in); got != tt.out {
+			t.Errorf("QuoteShell(%q) = %s, want %s", tt.in, got, tt.out)
+		}
+		if got := string(fastparse.AppendQuoteShell([]byte("x"), tt.in)); got != "x"+tt.out {
+			t.Errorf("AppendQuoteShell(x, %q) = %s, want x%s", tt.in, got, tt.out)
+		}
+	}
+	// Every byte that is not safe must force quoting, wherever it is.
+	for c := 0; c < 256; c++ {
+		safe := 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+			strings.IndexByte("@%+=:,./-_", byte(c)) >= 0
+		for _, n := range []int{0, 5, 31, 32, 33, 63, 100} {
+			s := strings.Repeat("a", n) + string([]byte{byte(c)}) + "z"
+			if quoted := fastparse.QuoteShell(s) != s; quoted == safe {
+				t.Errorf("QuoteShell(%q) quoted = %v, want %v", s, quoted, !safe)
+			}
+		}
+	}
+}
+
+var splitShellWordsTests = []struct {
+	in  string
+	out []string
+}{
+	{"", nil},
+	{"  \t\n ", nil},
+	{"a b  c", []string{"a", "b", "c"}},
+	{"  lead trail  ", []string{"lead", "trail"}},
+	{"''", []string{""}},
+	{`a '' b`, []string{"a", "", "b"}},
+	{`'single  quoted' x`, []string{"single  quoted", "x"}},
+	{`'a\b'`, []string{`a\b`}},
+	{`"double  quoted"`, []string{"double  quoted"}},
+	{"\"a\\$b\\`c\\\"d\\\\e\\f\"", []string{"a$b`c\"d\\e\\f"}},
+	{"\"line\\\ncont\"", []string{"linecont"}},
+	{`a\ b`, []string{"a b"}},
+	{`\'\"\\`, []string{`'"\`}},
+	{"a\\\nb", []string{"ab"}},
+	{"a \\\n b", []string{"a", "b"}},
+	{`'it'\''s'`, []string{"it's"}},
+	{`pre"mid"'post'`, []string{"premidpost"}},
+	{`$HOME *.go # | ;`, []string{"$HOME", "*.go", "#", "|", ";"}},
+	{"é 世", []string{"é", "世"}},
+}
+
+func TestSplitShellWords(t *testing.T) {
+	for _, tt := range splitShellWordsTests {
+		got, err := fastparse.SplitShellWords(tt.in)
+		if err != nil || !reflect.DeepEqual(got, tt.out) {
+			t.Errorf("SplitShellWords(%q) = %q, %v, want %q", tt.in, got, err, tt.out)
+		}
+	}
+}
+
+func TestSplitShellWordsErrors(t *testing.T) {
+	tests := []struct {
+		in     string
+		offset int
+		msg    string
+	}{
+		{`a 'b`, 2, "unterminated single quote"},
+		{`a "b`, 2, "unterminated double quote"},
+		{`"a\"`, 0, "unterminated double quote"},
+		{`"a\`, 0, "unterminated double quote"},
+		{`abc\`, 3, "trailing backslash"},
+		{`ok "fine" 'x' "y`, 14, "unterminated double quote"},
+	}
+	for _, tt := range tests {
+		words, err := fastparse.SplitShellWords(tt.in)
+		var se *fastparse.ShellSyntaxError
+		if !errors.As(err, &se) || se.Offset != tt.offset || se.Msg != tt.msg {
+			t.Errorf("SplitShellWords(%q) = %q, %v, want error %q at offset %d", tt.in, words, err, tt.msg, tt.offset)
+			continue
+		}
+		if !errors.Is(err, fastparse.ErrSyntax) {
+			t.Errorf("SplitShellWords(%q) error %v does not wrap ErrSyntax", tt.in, err)
+		}
+	}
+}
+
+func TestQuoteShellRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	const alphabet = "ab \t\n'\"\\$`*#;|&~=-_./\x00\xff"
+	for i := 0; i < 2000; i++ {
+		words := make([]string, r.Intn(5)+1)
+		quoted := make([]string, len(words))
+		for j := range words {
+			b := make([]byte, r.Intn(50))
+			for k := range b {
+				b[k] = alphabet[r.Intn(len(alphabet))]
+			}
+			words[j] = string(b)
+			quoted[j] = fastparse.QuoteShell(words[j])
+		}
+		line := strings.Join(quoted, " ")
+		got, err := fastparse.SplitShellWords(line)
+		if err != nil || !reflect.DeepEqual(got, words) {
+			t.Fatalf("SplitShellWords(%q) = %q, %v, want %q", line, got, err, words)
+		}
+	}
+}
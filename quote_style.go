@@ -0,0 +1,89 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import "github.com/mshafiee/fastparse/internal/quoting"
+
+// Style selects the language of the string literals written by
+// [QuoteStyle] and read by [UnquoteStyle].
+type Style uint8
+
+const (
+	// StyleC writes C string literals. Every byte outside printable ASCII,
+	// including each byte of a multi-byte character, is written as a
+	// three-digit octal escape such as \303, which cannot run on into a
+	// following digit the way \x does. The second ? of ?? is written as \?
+	// so that no trigraph forms.
+	StyleC Style = iota
+
+	// StylePython writes Python 3 str literals. Non-printable characters
+	// are written as \xHH, \uHHHH or \UHHHHHHHH.
+	StylePython
+
+	// StyleRust writes Rust string literals. Control characters without a
+	// short escape are written as \xHH and other non-printable characters
+	// as \u{h...}.
+	StyleRust
+
+	// StyleJS writes double-quoted JavaScript string literals.
+	// Non-printable characters are written as \xHH, \uHHHH or \u{h...},
+	// and U+2028 and U+2029 are always escaped. \0 is never written, since
+	// a following digit would turn it into a legacy octal escape.
+	StyleJS
+
+	// StyleJSTemplate writes JavaScript template literals, escaping like
+	// [StyleJS] and additionally escaping ` and the $ of ${.
+	StyleJSTemplate
+)
+
+var quoteStyles = [...]*quoting.Style{
+	StyleC:          quoting.CStyle,
+	StylePython:     quoting.PythonStyle,
+	StyleRust:       quoting.RustStyle,
+	StyleJS:         quoting.JSStyle,
+	StyleJSTemplate: quoting.JSTemplateStyle,
+}
+
+// quoting returns the internal description of the style.
+func (style Style) quoting() *quoting.Style {
+	if int(style) >= len(quoteStyles) {
+		panic("fastparse: invalid Style")
+	}
+	return quoteStyles[style]
+}
+
+// QuoteStyle returns a string literal representing s in the language
+// selected by style. See [AppendQuoteStyle].
+func QuoteStyle(s string, style Style) string {
+	return string(AppendQuoteStyle(make([]byte, 0, len(s)+2), s, style))
+}
+
+// AppendQuoteStyle appends a string literal representing s in the
+// language selected by style to dst and returns the extended buffer.
+//
+// Literals are double-quoted, except for [StyleJSTemplate], and printable
+// non-ASCII characters are written as they are, except for [StyleC]. The
+// result is valid source in the target language. Since only C strings
+// can hold arbitrary bytes, each invalid UTF-8 byte is written as an
+// escaped U+FFFD in the other styles.
+func AppendQuoteStyle(dst []byte, s string, style Style) []byte {
+	return quoting.AppendStyle(dst, s, style.quoting())
+}
+
+// UnquoteStyle interprets s as a string literal in the language selected
+// by style, returning the string value that s quotes.
+//
+// It accepts every escape of the language, not only those written by
+// [QuoteStyle], and single quotes as well in Python and JavaScript.
+// Python \N{name} escapes are not supported. Lone surrogates, which Go
+// strings cannot hold, are replaced by U+FFFD. If s is not a valid
+// literal, UnquoteStyle returns [ErrSyntax].
+func UnquoteStyle(s string, style Style) (string, error) {
+	b, ok := quoting.AppendUnquoteStyle(nil, s, style.quoting())
+	if !ok {
+		return "", ErrSyntax
+	}
+	return string(b), nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/mshafiee/fastparse"
+)
+
+// quoteStyleTests is a corpus of literals known to be valid in their
+// language: each was checked with gcc, python3, rustc and node to denote
+// its input.
+var quoteStyleTests = []struct {
+	style   fastparse.Style
+	in, out string
+}{
+	{fastparse.StyleC, "", `""`},
+	{fastparse.StyleC, "hello, world", `"hello, world"`},
+	{fastparse.StyleC, `say "hi" \ 'bye'`, `"say \"hi\" \\ 'bye'"`},
+	{fastparse.StyleC, "\a\b\f\n\r\t\v", `"\a\b\f\n\r\t\v"`},
+	{fastparse.StyleC, "\x00" + "1", `"\0001"`},
+	{fastparse.StyleC, "\x1b[0m\x7fA", `"\033[0m\177A"`},
+	{fastparse.StyleC, "caf\u00e9 \U0001F680", `"caf\303\251 \360\237\232\200"`},
+	{fastparse.StyleC, "\xff\xfeabc", `"\377\376abc"`},
+	{fastparse.StyleC, "what? ??= ??/ ???", `"what? ?\?= ?\?/ ?\?\?"`},
+
+	{fastparse.StylePython, "", `""`},
+	{fastparse.StylePython, `say "hi" \ 'bye'`, `"say \"hi\" \\ 'bye'"`},
+	{fastparse.StylePython, "\a\b\f\n\r\t\v", `"\a\b\f\n\r\t\v"`},
+	{fastparse.StylePython, "\x00" + "1\x1b\x7f", `"\x001\x1b\x7f"`},
+	{fastparse.StylePython, "caf\u00e9 \u4e16 \U0001F680", "\"caf\u00e9 \u4e16 \U0001F680\""},
+	{fastparse.StylePython, `\N{BULLET} \U0001F680`, `"\\N{BULLET} \\U0001F680"`},
+	{fastparse.StylePython, "\xff", `"\ufffd"`},
+	{fastparse.StylePython, "{x} %s ??=", `"{x} %s ??="`},
+
+	{fastparse.StyleRust, "", `""`},
+	{fastparse.StyleRust, `say "hi" \ 'bye'`, `"say \"hi\" \\ 'bye'"`},
+	{fastparse.StyleRust, "\n\r\t", `"\n\r\t"`},
+	{fastparse.StyleRust, "\a\b\f\v", `"\x07\x08\x0c\x0b"`},
+	{fastparse.StyleRust, "\x00" + "1\x7f", `"\01\x7f"`},
+	{fastparse.StyleRust, "caf\u00e9 \U0001F680", "\"caf\u00e9 \U0001F680\""},
+	{fastparse.StyleRust, "\xff\xc3", `"\u{fffd}\u{fffd}"`},
+	{fastparse.StyleRust, `\u{41}`, `"\\u{41}"`},
+
+	{fastparse.StyleJS, "", `""`},
+	{fastparse.StyleJS, `say "hi" \ 'bye'`, `"say \"hi\" \\ 'bye'"`},
+	{fastparse.StyleJS, "\b\f\n\r\t\v\a", `"\b\f\n\r\t\v\x07"`},
+	{fastparse.StyleJS, "\x00" + "1\x7f", `"\x001\x7f"`},
+	{fastparse.StyleJS, "caf\u00e9 \U0001F680", "\"caf\u00e9 \U0001F680\""},
+	{fastparse.StyleJS, "\u2028\u2029", `"\u2028\u2029"`},
+	{fastparse.StyleJS, "\xff", `"\ufffd"`},
+	{fastparse.StyleJS, "${x} `y` </script>", "\"${x} `y` </script>\""},
+
+	{fastparse.StyleJSTemplate, "", "``"},
+	{fastparse.StyleJSTemplate, `say "hi" 'bye'`, "`say \"hi\" 'bye'`"},
+	{fastparse.StyleJSTemplate, "${x} $ {y} $$ $", "`\\${x} $ {y} $$ $`"},
+	{fastparse.StyleJSTemplate, "`tick` \\", "`\\`tick\\` \\\\`"},
+	{fastparse.StyleJSTemplate, "a\nb\x00" + "1", "`a\\nb\\x001`"},
+	{fastparse.StyleJSTemplate, "caf\u00e9 \u2028", "`caf\u00e9 \\u2028`"},
+}
+
+func TestQuoteStyle(t *testing.T) {
+	for _, tt := range quoteStyleTests {
+		if got := fastparse.QuoteStyle(tt.in, tt.style); got != tt.out {
+			t.Errorf("QuoteStyle(%q, %d) = %s, want %s", tt.in, tt.style, got, tt.out)
+		}
+		prefix := []byte("prefix")
+		if got := string(fastparse.AppendQuoteStyle(prefix, tt.in, tt.style)); got != "prefix"+tt.out {
+			t.Errorf("AppendQuoteStyle(%q, %d) = %s, want prefix%s", tt.in, tt.style, got, tt.out)
+		}
+		// The corpus quotes valid UTF-8 outside C, so it reads back exactly.
+		if strings.ContainsRune(tt.in, 0xFFFD) || strings.ToValidUTF8(tt.in, "") != tt.in && tt.style != fastparse.StyleC {
+			continue
+		}
+		if got, err := fastparse.UnquoteStyle(tt.out, tt.style); err != nil || got != tt.in {
+			t.Errorf("UnquoteStyle(%s, %d) = %q, %v, want %q", tt.out, tt.style, got, err, tt.in)
+		}
+	}
+}
+
+var unquoteStyleTests = []struct {
+	style   fastparse.Style
+	in, out string
+}{
+	{fastparse.StyleC, `"\x41\x4a\x0041"`, "AJA"},
+	{fastparse.StyleC, `"\101\060\1234\0"`, "A0S4\x00"},
+	{fastparse.StyleC, `"é\U0001F680$"`, "\u00e9\U0001F680$"},
+	{fastparse.StyleC, `"\?\'\"\\"`, `?'"\`},
+	{fastparse.StyleC, "\"a\\\nb\"", "ab"},
+	{fastparse.StyleC, "\"\xff\t\"", "\xff\t"},
+
+	{fastparse.StylePython, `'it\'s'`, "it's"},
+	{fastparse.StylePython, `"\x41é\U0001F680"`, "A\u00e9\U0001F680"},
+	{fastparse.StylePython, `"\101\0\777"`, "A\x00\u01ff"},
+	{fastparse.StylePython, `"\q\8\{"`, `\q\8\{`},
+	{fastparse.StylePython, `"\ud83d\ude80"`, "\ufffd\ufffd"},
+	{fastparse.StylePython, "\"a\\\nb\"", "ab"},
+
+	{fastparse.StyleRust, `"\u{1F680}\u{e9}\u{10_FFFF}\u{0_0_4_1}"`, "\U0001F680\u00e9\U0010FFFFA"},
+	{fastparse.StyleRust, `"\x41\0\'\""`, "A\x00'\""},
+	{fastparse.StyleRust, "\"a\\\n   \t\n b\"", "ab"},
+	{fastparse.StyleRust, "\"a\nb\r\nc\"", "a\nb\nc"},
+
+	{fastparse.StyleJS, `'a\'b'`, "a'b"},
+	{fastparse.StyleJS, `"\u{1F680}\uD83D\uDE80\u{D83D}\u{DE80}\ud83d\u{de80}"`, "\U0001F680\U0001F680\U0001F680\U0001F680"},
+	{fastparse.StyleJS, `"\uD800x\uDC00\uD800\uD800"`, "\ufffdx\ufffd\ufffd\ufffd"},
+	{fastparse.StyleJS, `"\q\é\0\x41\u{000041}"`, "q\u00e9\x00AA"},
+	{fastparse.StyleJS, "\"a\\\nb\\\r\nc\\\u2028d\"", "abcd"},
+	{fastparse.StyleJS, "\"a\u2028b\"", "a\u2028b"},
+
+	{fastparse.StyleJSTemplate, "`a\nb\r\nc\rd`", "a\nb\nc\nd"},
+	{fastparse.StyleJSTemplate, "`\\${} $ {} $`", "${} $ {} $"},
+	{fastparse.StyleJSTemplate, "`\"'\\``", "\"'`"},
+}
+
+func TestUnquoteStyle(t *testing.T) {
+	for _, tt := range unquoteStyleTests {
+		if got, err := fastparse.UnquoteStyle(tt.in, tt.style); err != nil || got != tt.out {
+			t.Errorf("UnquoteStyle(%s, %d) = %q, %v, want %q", tt.in, tt.style, got, err, tt.out)
+		}
+	}
+}
+
+func TestUnquoteStyleErrors(t *testing.T) {
+	tests := []struct {
+		style fastparse.Style
+		in    string
+	}{
+		{fastparse.StyleC, ``},
+		{fastparse.StyleC, `"`},
+		{fastparse.StyleC, `"abc`},
+		{fastparse.StyleC, `'a'`},
+		{fastparse.StyleC, `"a"b"`},
+		{fastparse.StyleC, `"a\"`},
+		{fastparse.StyleC, "\"a\nb\""},
+		{fastparse.StyleC, `"\x"`},
+		{fastparse.StyleC, `"\x100"`},
+		{fastparse.StyleC, `"\400"`},
+		{fastparse.StyleC, `"\u0041"`},
+		{fastparse.StyleC, `"\ud800"`},
+		{fastparse.StyleC, `"\U00110000"`},
+		{fastparse.StyleC, `"\q"`},
+
+		{fastparse.StylePython, `"it's'`},
+		{fastparse.StylePython, `"\N{BULLET}"`},
+		{fastparse.StylePython, `"\x4"`},
+		{fastparse.StylePython, `"\u123"`},
+		{fastparse.StylePython, `"\U00110000"`},
+		{fastparse.StylePython, "\"a\nb\""},
+		{fastparse.StylePython, "\"\xff\""},
+
+		{fastparse.StyleRust, `'a'`},
+		{fastparse.StyleRust, `"\x80"`},
+		{fastparse.StyleRust, `"\a"`},
+		{fastparse.StyleRust, `"\u{D800}"`},
+		{fastparse.StyleRust, `"\u{1234567}"`},
+		{fastparse.StyleRust, `"\u{110000}"`},
+		{fastparse.StyleRust, `"\u{_1}"`},
+		{fastparse.StyleRust, `"\u{}"`},
+		{fastparse.StyleRust, `"\u0041"`},
+		{fastparse.StyleRust, "\"a\rb\""},
+
+		{fastparse.StyleJS, "`a`"},
+		{fastparse.StyleJS, `"\01"`},
+		{fastparse.StyleJS, `"\1"`},
+		{fastparse.StyleJS, `"\8"`},
+		{fastparse.StyleJS, `"\x4"`},
+		{fastparse.StyleJS, `"\u12"`},
+		{fastparse.StyleJS, `"\u{110000}"`},
+		{fastparse.StyleJS, `"\u{}"`},
+		{fastparse.StyleJS, "\"a\nb\""},
+		{fastparse.StyleJS, "\"a\rb\""},
+
+		{fastparse.StyleJSTemplate, `"a"`},
+		{fastparse.StyleJSTemplate, "`${x}`"},
+		{fastparse.StyleJSTemplate, "`a`b`"},
+		{fastparse.StyleJSTemplate, "`\\01`"},
+	}
+	for _, tt := range tests {
+		if got, err := fastparse.UnquoteStyle(tt.in, tt.style); err != fastparse.ErrSyntax {
+			t.Errorf("UnquoteStyle(%s, %d) = %q, %v, want ErrSyntax", tt.in, tt.style, got, err)
+		}
+	}
+}
+
+func TestQuoteStyleRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	pieces := []string{
+		"\"", "'", "`", "\\", "?", "??", "$", "${", "{", "0", "7", "f",
+		"\x00", "\a", "\n", "\r", "\x1b", "\x7f", "\u00e9", "\u2028",
+		"\U0001F680", "\xff", "\xed\xa0\x80",
+	}
+	for i := 0; i < 2000; i++ {
+		var b []byte
+		for n := r.Intn(30); n > 0; n-- {
+			if r.Intn(2) == 0 {
+				b = append(b, pieces[r.Intn(len(pieces))]...)
+			} else {
+				b = append(b, byte('a'+r.Intn(26)))
+			}
+		}
+		s := string(b)
+		for style := fastparse.StyleC; style <= fastparse.StyleJSTemplate; style++ {
+			// Only C strings hold invalid UTF-8; elsewhere each invalid
+			// byte reads back as U+FFFD.
+			want := s
+			if style != fastparse.StyleC {
+				want = string([]rune(s))
+			}
+			q := fastparse.QuoteStyle(s, style)
+			if got, err := fastparse.UnquoteStyle(q, style); err != nil || got != want {
+				t.Fatalf("UnquoteStyle(QuoteStyle(%q, %d) = %s) = %q, %v, want %q", s, style, q, got, err, want)
+			}
+		}
+	}
+}
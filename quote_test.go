@@ -5,16 +5,88 @@
 package fastparse
 
 import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"unicode"
+
+	"github.com/mshafiee/fastparse/internal/unicode_tables"
 )
 
-// Verify that our IsPrint agrees with unicode.IsPrint.
+// ucdCategories holds the General_Category of every rune as given by
+// testdata/UnicodeData.txt, empty for unassigned ones. The tables are
+// checked against this file rather than the unicode package, whose version
+// follows the toolchain.
+var ucdCategories = sync.OnceValues(func() ([]string, error) {
+	f, err := os.Open("testdata/UnicodeData.txt")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	cats := make([]string, unicode.MaxRune+1)
+	first := rune(-1)
+	s := bufio.NewScanner(f)
+	for line := 1; s.Scan(); line++ {
+		if s.Text() == "" || strings.HasPrefix(s.Text(), "#") {
+			continue
+		}
+		fields := strings.Split(s.Text(), ";")
+		if len(fields) != 15 {
+			return nil, fmt.Errorf("UnicodeData.txt:%d: %d fields, want 15", line, len(fields))
+		}
+		v, err := strconv.ParseUint(fields[0], 16, 32)
+		if err != nil || v > unicode.MaxRune {
+			return nil, fmt.Errorf("UnicodeData.txt:%d: bad code point %q", line, fields[0])
+		}
+		r := rune(v)
+		switch {
+		case strings.HasSuffix(fields[1], ", First>"):
+			first = r
+			continue
+		case strings.HasSuffix(fields[1], ", Last>"):
+			if first < 0 || first > r {
+				return nil, fmt.Errorf("UnicodeData.txt:%d: range end without start", line)
+			}
+		default:
+			first = r
+		}
+		for ; first <= r; first++ {
+			cats[first] = fields[2]
+		}
+		first = -1
+	}
+	return cats, s.Err()
+})
+
+// readUCD returns, for every rune, whether testdata/UnicodeData.txt makes
+// it printable and graphic in the sense of [unicode.IsPrint] and
+// [unicode.IsGraphic].
+func readUCD(t *testing.T) (isPrint, isGraphic func(rune) bool) {
+	t.Helper()
+	cats, err := ucdCategories()
+	if err != nil {
+		t.Fatal(err)
+	}
+	isPrint = func(r rune) bool {
+		return r == ' ' || cats[r] != "" && strings.Contains("LMNPS", cats[r][:1])
+	}
+	isGraphic = func(r rune) bool {
+		return isPrint(r) || cats[r] == "Zs"
+	}
+	return isPrint, isGraphic
+}
+
+// Verify that our IsPrint agrees with the vendored Unicode data.
 func TestIsPrint(t *testing.T) {
+	isPrint, _ := readUCD(t)
 	n := 0
 	for r := rune(0); r <= unicode.MaxRune; r++ {
-		if IsPrint(r) != unicode.IsPrint(r) {
+		if IsPrint(r) != isPrint(r) {
 			t.Errorf("IsPrint(%U)=%t incorrect", r, IsPrint(r))
 			n++
 			if n > 10 {
@@ -24,11 +96,12 @@ func TestIsPrint(t *testing.T) {
 	}
 }
 
-// Verify that our IsGraphic agrees with unicode.IsGraphic.
+// Verify that our IsGraphic agrees with the vendored Unicode data.
 func TestIsGraphic(t *testing.T) {
+	_, isGraphic := readUCD(t)
 	n := 0
 	for r := rune(0); r <= unicode.MaxRune; r++ {
-		if IsGraphic(r) != unicode.IsGraphic(r) {
+		if IsGraphic(r) != isGraphic(r) {
 			t.Errorf("IsGraphic(%U)=%t incorrect", r, IsGraphic(r))
 			n++
 			if n > 10 {
@@ -38,6 +111,46 @@ func TestIsGraphic(t *testing.T) {
 	}
 }
 
+// Verify that the lookups shared with the quoting code agree with the
+// vendored Unicode data on every rune.
+func TestUnicodeTables(t *testing.T) {
+	isPrint, isGraphic := readUCD(t)
+	n := 0
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if unicode_tables.IsPrint(r) != isPrint(r) || unicode_tables.IsGraphic(r) != isGraphic(r) {
+			t.Errorf("unicode_tables: IsPrint(%U)=%t, IsGraphic(%U)=%t incorrect",
+				r, unicode_tables.IsPrint(r), r, unicode_tables.IsGraphic(r))
+			n++
+			if n > 10 {
+				return
+			}
+		}
+	}
+}
+
+// Verify that the tables match the unicode package wherever both know the
+// rune, so a version difference can only add or drop assignments.
+func TestIsPrintUnicodePackage(t *testing.T) {
+	cats, err := ucdCategories()
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if cats[r] == "" || cats[r] == "Cn" || !unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.P, unicode.S, unicode.Z, unicode.C) {
+			continue
+		}
+		if IsPrint(r) != unicode.IsPrint(r) || IsGraphic(r) != unicode.IsGraphic(r) {
+			t.Errorf("IsPrint(%U)=%t, IsGraphic(%U)=%t disagree with unicode %s",
+				r, IsPrint(r), r, IsGraphic(r), unicode.Version)
+			n++
+			if n > 10 {
+				return
+			}
+		}
+	}
+}
+
 type quoteTest struct {
 	in      string
 	out     string
@@ -90,6 +203,29 @@ func TestQuoteToGraphic(t *testing.T) {
 	}
 }
 
+// TestQuoteBulkScan puts each kind of byte that ends a bulk-copied run at
+// every offset of clean strings around the vector widths.
+func TestQuoteBulkScan(t *testing.T) {
+	stops := []string{"\"", "'", "\\", "\x00", "\n", "\x1f", "\x7f", "\x80", "\xff", "\u00e9", "\u00a0", "\U0001F680"}
+	for _, n := range []int{0, 1, 7, 8, 9, 15, 16, 17, 31, 32, 33, 63, 64, 65, 100, 1000} {
+		clean := strings.Repeat("abcdefghij ", n/11+1)[:n]
+		for _, stop := range append(stops, "") {
+			for i := 0; i <= n; i++ {
+				s := clean[:i] + stop + clean[i:]
+				if got, want := Quote(s), strconv.Quote(s); got != want {
+					t.Fatalf("Quote(%q) = %s, want %s", s, got, want)
+				}
+				if got, want := QuoteToASCII(s), strconv.QuoteToASCII(s); got != want {
+					t.Fatalf("QuoteToASCII(%q) = %s, want %s", s, got, want)
+				}
+				if got, want := QuoteToGraphic(s), strconv.QuoteToGraphic(s); got != want {
+					t.Fatalf("QuoteToGraphic(%q) = %s, want %s", s, got, want)
+				}
+			}
+		}
+	}
+}
+
 func BenchmarkQuote(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		Quote("\a\b\f\r\n\t\v\a\b\f\r\n\t\v\a\b\f\r\n\t\v")
@@ -354,6 +490,14 @@ func testUnquote(t *testing.T, in, want string, wantErr error) {
 		t.Errorf("Unquote(%q) = (%q, %v), want (%q, %v)", in, got, gotErr, want, wantErr)
 	}
 
+	// Test AppendUnquote and UnquoteBytes.
+	if b, err := AppendUnquote([]byte("x"), in); string(b) != "x"+want || err != wantErr {
+		t.Errorf("AppendUnquote(%q) = (%q, %v), want (%q, %v)", in, b, err, "x"+want, wantErr)
+	}
+	if b, err := UnquoteBytes([]byte(in)); string(b) != want || err != wantErr {
+		t.Errorf("UnquoteBytes(%q) = (%q, %v), want (%q, %v)", in, b, err, want, wantErr)
+	}
+
 	// Test QuotedPrefix.
 	// Adding an arbitrary suffix should not change the result of QuotedPrefix
 	// assume that the suffix doesn't accidentally terminate a truncated input.
@@ -384,6 +528,75 @@ func testUnquote(t *testing.T, in, want string, wantErr error) {
 	}
 }
 
+func TestUnquoteBytesInPlace(t *testing.T) {
+	for _, tt := range []struct {
+		in, want string
+		inPlace  bool
+	}{
+		{`"plain text that takes the bulk copy path, twice over"`, "plain text that takes the bulk copy path, twice over", true},
+		{`"tab\there \u00e9\U0001F680 \x41\101"`, "tab\there \u00e9\U0001F680 AA", true},
+		{"`raw\r\nstring`", "raw\nstring", true},
+		{`'\''`, "'", true},
+		{`"` + "\xff\xff\xff" + `"`, strings.Repeat("\uFFFD", 3), false},
+		{`"` + "long prefix \xc0" + `"`, "long prefix \uFFFD", true},
+	} {
+		b := []byte(tt.in)
+		got, err := UnquoteBytes(b)
+		if string(got) != tt.want || err != nil {
+			t.Errorf("UnquoteBytes(%q) = (%q, %v), want (%q, nil)", tt.in, got, err, tt.want)
+			continue
+		}
+		if inPlace := len(got) == 0 || &got[0] == &b[0]; inPlace != tt.inPlace {
+			t.Errorf("UnquoteBytes(%q) in place = %t, want %t", tt.in, inPlace, tt.inPlace)
+		}
+	}
+}
+
+// TestAppendUnquoteRandom checks AppendUnquote and UnquoteBytes against
+// strconv.Unquote on literals built from escapes, quotes and runs long
+// enough for the vector scan.
+func TestAppendUnquoteRandom(t *testing.T) {
+	pieces := []string{
+		"a", "\\", `"`, "'", "`", "\n", "\r", "\xff", "\xe9", "é", "\xf0\x9f",
+		`\x41`, `\u00e9`, `\U0001F680`, `\ud800`, `\n`, `\"`, `\'`, `\377`, `\q`,
+		"the quick brown fox jumps over the lazy dog",
+	}
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 20000; n++ {
+		q := "\"'`"[r.Intn(3)]
+		s := []byte{q}
+		for k := r.Intn(12); k > 0; k-- {
+			s = append(s, pieces[r.Intn(len(pieces))]...)
+		}
+		if r.Intn(10) > 0 {
+			s = append(s, q)
+		}
+		want, wantErr := strconv.Unquote(string(s))
+		got, err := AppendUnquote(nil, string(s))
+		if (err == nil) != (wantErr == nil) || string(got) != want {
+			t.Fatalf("AppendUnquote(%q) = (%q, %v), want (%q, %v)", s, got, err, want, wantErr)
+		}
+		got, err = UnquoteBytes(s)
+		if (err == nil) != (wantErr == nil) || string(got) != want {
+			t.Fatalf("UnquoteBytes(%q) = (%q, %v), want (%q, %v)", s, got, err, want, wantErr)
+		}
+	}
+}
+
+func TestAppendUnquoteAllocs(t *testing.T) {
+	in := `"\x47ive me a \x72ock, \x70aper and \x73cissors and \u0049 will move the world."`
+	buf := make([]byte, 0, len(in))
+	b := []byte(in)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = AppendUnquote(buf[:0], in)
+		copy(b, in)
+		UnquoteBytes(b)
+	})
+	if allocs != 0 {
+		t.Errorf("AppendUnquote and UnquoteBytes allocate %v times, want 0", allocs)
+	}
+}
+
 func BenchmarkUnquoteEasy(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		Unquote(`"Give me a rock, paper and scissors and I will move the world."`)
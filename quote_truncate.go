@@ -0,0 +1,56 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import "github.com/mshafiee/fastparse/internal/quoting"
+
+// QuoteMode selects the literal syntax of [AppendQuoteTruncated].
+type QuoteMode uint8
+
+const (
+	QuoteModeGo       QuoteMode = iota // a Go string literal, as written by [Quote]
+	QuoteModeASCII                     // as written by [QuoteToASCII]
+	QuoteModeGraphic                   // as written by [QuoteToGraphic]
+	QuoteModeJSON                      // a JSON string literal, as written by [QuoteJSON] without flags
+	QuoteModeJSONHTML                  // as written by [QuoteJSON] with [JSONEscapeHTML]
+)
+
+var quoteModeLiterals = [...]quoting.Literal{
+	QuoteModeGo:       {Mode: quoting.ModePrint},
+	QuoteModeASCII:    {Mode: quoting.ModeASCII},
+	QuoteModeGraphic:  {Mode: quoting.ModeGraphic},
+	QuoteModeJSON:     {JSON: true},
+	QuoteModeJSONHTML: {JSON: true, HTML: true},
+}
+
+// QuoteTruncated returns a double-quoted literal representing s, cut to
+// at most maxBytes bytes. See [AppendQuoteTruncated].
+func QuoteTruncated(s string, maxBytes int, mode QuoteMode) string {
+	return string(AppendQuoteTruncated(make([]byte, 0, min(len(s)+2, max(maxBytes, 2))), s, maxBytes, mode))
+}
+
+// AppendQuoteTruncated appends a double-quoted literal representing s in
+// the syntax selected by mode to dst, and returns the extended buffer.
+// The literal, quotes included, is at most maxBytes bytes long.
+//
+// If the whole literal does not fit, it holds the longest prefix of s
+// that does followed by the marker ...(+n bytes), where n is the number
+// of bytes of s left out. A UTF-8 sequence or escape is never split, so
+// the literal is always valid and closed. If maxBytes leaves no room for
+// the count, the marker is only the ellipsis, and if it leaves none for
+// that either, the literal is empty. The output is budgeted as it is
+// written, without a separate pass to measure it.
+//
+// AppendQuoteTruncated panics if maxBytes is less than 2 or mode is
+// invalid.
+func AppendQuoteTruncated(dst []byte, s string, maxBytes int, mode QuoteMode) []byte {
+	if maxBytes < 2 {
+		panic("fastparse: AppendQuoteTruncated needs maxBytes >= 2")
+	}
+	if int(mode) >= len(quoteModeLiterals) {
+		panic("fastparse: invalid QuoteMode")
+	}
+	return quoting.AppendTruncated(dst, s, maxBytes, quoteModeLiterals[mode])
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/mshafiee/fastparse"
+)
+
+var quoteTruncatedTests = []struct {
+	in       string
+	maxBytes int
+	mode     fastparse.QuoteMode
+	out      string
+}{
+	{"hello", 7, fastparse.QuoteModeGo, `"hello"`},
+	{"hello", 100, fastparse.QuoteModeJSON, `"hello"`},
+	{strings.Repeat("x", 100), 30, fastparse.QuoteModeGo, `"xxxxxxxxxxxxxx...(+86 bytes)"`},
+	{strings.Repeat("x", 100), 31, fastparse.QuoteModeGo, `"xxxxxxxxxxxxxxx...(+85 bytes)"`},
+	{strings.Repeat("x", 9) + strings.Repeat("y", 6), 16, fastparse.QuoteModeGo, `"...(+15 bytes)"`},
+	{strings.Repeat("x", 9) + strings.Repeat("y", 6), 17, fastparse.QuoteModeGo, `"xxxxxxxxxyyyyyy"`},
+	{"a\nb\nc\nd\ne\nf\ng\nh", 20, fastparse.QuoteModeGo, `"a\nb...(+12 bytes)"`},
+	{"\xff\xfe\xfd\xfc\xfb\xfa\xf9\xf8", 22, fastparse.QuoteModeGo, `"\xff...(+7 bytes)"`},
+	{"héllo wörld, héllo wörld", 22, fastparse.QuoteModeASCII, `"h...(+27 bytes)"`},
+	{"<b>bold</b> and more text", 25, fastparse.QuoteModeJSONHTML, `"\u003cb...(+23 bytes)"`},
+	{"<b>bold</b> and more text", 25, fastparse.QuoteModeJSON, `"<b>bold</...(+16 bytes)"`},
+	{strings.Repeat("x", 100), 10, fastparse.QuoteModeGo, `"xxxxx..."`},
+	{strings.Repeat("x", 100), 6, fastparse.QuoteModeGo, `"x..."`},
+	{strings.Repeat("x", 100), 5, fastparse.QuoteModeGo, `"..."`},
+	{strings.Repeat("x", 100), 4, fastparse.QuoteModeJSON, `""`},
+	{strings.Repeat("x", 100), 2, fastparse.QuoteModeJSON, `""`},
+}
+
+func TestQuoteTruncated(t *testing.T) {
+	for _, tt := range quoteTruncatedTests {
+		if out := fastparse.QuoteTruncated(tt.in, tt.maxBytes, tt.mode); out != tt.out {
+			t.Errorf("QuoteTruncated(%q, %d, %d) = %s, want %s", tt.in, tt.maxBytes, tt.mode, out, tt.out)
+		}
+	}
+}
+
+// truncMarkerLen is the length of the marker for n omitted bytes.
+func truncMarkerLen(n int) int {
+	return len("...(+" + strconv.Itoa(n) + " bytes)")
+}
+
+// TestQuoteTruncatedRandom checks that truncated literals stay within
+// their budget, unquote to a prefix of the input and the marker, and
+// could not have held one more character.
+func TestQuoteTruncatedRandom(t *testing.T) {
+	pieces := []string{
+		"a", "the quick brown fox ", `"`, `\`, "\n", "\x00", "<&>", "é", "世界", "\U0001F680", "\xff",
+	}
+	quote := map[fastparse.QuoteMode]func(string) string{
+		fastparse.QuoteModeGo:       strconv.Quote,
+		fastparse.QuoteModeASCII:    strconv.QuoteToASCII,
+		fastparse.QuoteModeGraphic:  strconv.QuoteToGraphic,
+		fastparse.QuoteModeJSON:     func(s string) string { return fastparse.QuoteJSON(s, 0) },
+		fastparse.QuoteModeJSONHTML: func(s string) string { return fastparse.QuoteJSON(s, fastparse.JSONEscapeHTML) },
+	}
+	unquote := func(mode fastparse.QuoteMode, lit string) (string, error) {
+		if mode >= fastparse.QuoteModeJSON {
+			var v string
+			err := json.Unmarshal([]byte(lit), &v)
+			return v, err
+		}
+		return strconv.Unquote(lit)
+	}
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 20000; n++ {
+		var b strings.Builder
+		for k := r.Intn(20); k > 0; k-- {
+			b.WriteString(pieces[r.Intn(len(pieces))])
+		}
+		s := b.String()
+		maxBytes := 2 + r.Intn(80)
+		for mode, q := range quote {
+			out := fastparse.QuoteTruncated(s, maxBytes, mode)
+			if len(out) > maxBytes {
+				t.Fatalf("QuoteTruncated(%q, %d, %d) = %s, longer than %d", s, maxBytes, mode, out, maxBytes)
+			}
+			if full := q(s); len(full) <= maxBytes {
+				if out != full {
+					t.Fatalf("QuoteTruncated(%q, %d, %d) = %s, want %s", s, maxBytes, mode, out, full)
+				}
+				continue
+			}
+			v, err := unquote(mode, out)
+			if err != nil {
+				t.Fatalf("QuoteTruncated(%q, %d, %d) = %s, which does not unquote: %v", s, maxBytes, mode, out, err)
+			}
+			i := strings.LastIndex(v, "...")
+			if i < 0 {
+				continue // too short for a marker
+			}
+			if !strings.HasSuffix(v, " bytes)") {
+				if 2+truncMarkerLen(len(s)) <= maxBytes {
+					t.Fatalf("QuoteTruncated(%q, %d, %d) = %s, without a count", s, maxBytes, mode, out)
+				}
+				continue
+			}
+			prefix := v[:strings.LastIndex(v, "...(+")]
+			if mode == fastparse.QuoteModeJSON || mode == fastparse.QuoteModeJSONHTML {
+				if !utf8.ValidString(s) {
+					continue
+				}
+			}
+			if !strings.HasPrefix(s, prefix) || v[len(prefix):] != "...(+"+strconv.Itoa(len(s)-len(prefix))+" bytes)" {
+				t.Fatalf("QuoteTruncated(%q, %d, %d) = %s, not a prefix and its count", s, maxBytes, mode, out)
+			}
+			_, width := utf8.DecodeRuneInString(s[len(prefix):])
+			next := s[:len(prefix)+width]
+			if len(q(next))+truncMarkerLen(len(s)-len(next)) <= maxBytes {
+				t.Fatalf("QuoteTruncated(%q, %d, %d) = %s, but %s fits", s, maxBytes, mode, out, q(next))
+			}
+		}
+	}
+}
+
+func TestQuoteTruncatedPanics(t *testing.T) {
+	for _, tt := range []struct {
+		maxBytes int
+		mode     fastparse.QuoteMode
+	}{
+		{1, fastparse.QuoteModeGo},
+		{10, fastparse.QuoteModeJSONHTML + 1},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("AppendQuoteTruncated(%d, %d) did not panic", tt.maxBytes, tt.mode)
+				}
+			}()
+			fastparse.AppendQuoteTruncated(nil, "abc", tt.maxBytes, tt.mode)
+		}()
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"unicode/utf8"
+
+	"github.com/mshafiee/fastparse/internal/quoting"
+)
+
+// QuoterOptions describes the quoting policy of a [Quoter]. The zero value
+// is the policy of [Quote].
+type QuoterOptions struct {
+	// Quote is the quote character, '"' if zero. It must be printable
+	// ASCII other than a backslash, and is escaped with a backslash inside
+	// the literal.
+	Quote byte
+
+	// ASCII escapes every non-ASCII character, as [QuoteToASCII] does.
+	ASCII bool
+
+	// Graphic leaves the characters for which [IsGraphic] holds
+	// unescaped, rather than only those for which [IsPrint] holds, as
+	// [QuoteToGraphic] does.
+	Graphic bool
+
+	// UnicodeBytes writes escaped ASCII bytes as \u00HH rather than \xHH.
+	// Bytes of invalid UTF-8 are always written as \xHH.
+	UnicodeBytes bool
+
+	// Backquote writes a raw backquoted string instead whenever
+	// [CanBackquote] reports that it can represent s.
+	Backquote bool
+
+	// Escape lists additional ASCII bytes to escape, such as "$".
+	Escape string
+}
+
+// Byte actions of a Quoter. Any other value is the letter of a short
+// escape: \n for '\n', \\ for '\\' and so on.
+const (
+	quoteLiteral = iota // copied as is
+	quoteHex            // written as \xHH or \u00HH
+	quoteRune           // starts a multi-byte character
+)
+
+// A Quoter writes Go-style quoted string literals under a fixed policy.
+//
+// The policy is compiled by [NewQuoter] into an action for each byte
+// value, and runs of bytes that need no escaping are found with the same
+// SIMD scan that [Quote] uses. A Quoter is safe for concurrent use.
+type Quoter struct {
+	quote          byte
+	ascii, graphic bool
+	unicodeBytes   bool
+	backquote      bool
+	actions        [256]byte
+	class          *quoting.ByteClass // bytes to stop at, if not those of IndexEscape
+}
+
+// The preset Quoters behind [Quote], [QuoteToASCII] and [QuoteToGraphic]
+// and their Append forms.
+var (
+	goQuoter      = NewQuoter(QuoterOptions{})
+	asciiQuoter   = NewQuoter(QuoterOptions{ASCII: true})
+	graphicQuoter = NewQuoter(QuoterOptions{Graphic: true})
+)
+
+// GoQuoter returns the Quoter used by [Quote] and [AppendQuote].
+func GoQuoter() *Quoter { return goQuoter }
+
+// ASCIIQuoter returns the Quoter used by [QuoteToASCII] and
+// [AppendQuoteToASCII].
+func ASCIIQuoter() *Quoter { return asciiQuoter }
+
+// GraphicQuoter returns the Quoter used by [QuoteToGraphic] and
+// [AppendQuoteToGraphic].
+func GraphicQuoter() *Quoter { return graphicQuoter }
+
+// NewQuoter returns a Quoter with the policy described by opts. It panics
+// if opts.Quote is not a valid quote character or opts.Escape holds a
+// non-ASCII byte.
+func NewQuoter(opts QuoterOptions) *Quoter {
+	q := &Quoter{
+		quote:        opts.Quote,
+		ascii:        opts.ASCII,
+		graphic:      opts.Graphic,
+		unicodeBytes: opts.UnicodeBytes,
+		backquote:    opts.Backquote,
+	}
+	if q.quote == 0 {
+		q.quote = '"'
+	}
+	if q.quote < ' ' || q.quote >= 0x7f || q.quote == '\\' {
+		panic("fastparse: invalid Quoter quote character")
+	}
+	for c := 0; c < len(q.actions); c++ {
+		switch {
+		case c >= utf8.RuneSelf:
+			q.actions[c] = quoteRune
+		case c < ' ' || c == 0x7f:
+			q.actions[c] = quoteHex
+		}
+	}
+	for _, e := range [...]struct{ c, letter byte }{
+		{'\a', 'a'}, {'\b', 'b'}, {'\f', 'f'}, {'\n', 'n'}, {'\r', 'r'}, {'\t', 't'}, {'\v', 'v'},
+	} {
+		q.actions[e.c] = e.letter
+	}
+	q.actions[q.quote] = q.quote
+	q.actions['\\'] = '\\'
+	extra := false
+	for i := 0; i < len(opts.Escape); i++ {
+		c := opts.Escape[i]
+		if c >= utf8.RuneSelf {
+			panic("fastparse: non-ASCII byte in QuoterOptions.Escape")
+		}
+		if q.actions[c] == quoteLiteral {
+			q.actions[c] = quoteHex
+			extra = true
+		}
+	}
+	if extra {
+		q.class = quoting.NewByteClass(func(b byte) bool { return q.actions[b] != quoteLiteral })
+	}
+	return q
+}
+
+// Quote returns a quoted string literal representing s. See [Quoter.Append].
+func (q *Quoter) Quote(s string) string {
+	return string(q.Append(make([]byte, 0, 3*len(s)/2), s))
+}
+
+// Append appends a quoted string literal representing s to dst and
+// returns the extended buffer.
+func (q *Quoter) Append(dst []byte, s string) []byte {
+	if q.backquote && CanBackquote(s) {
+		dst = append(dst, '`')
+		dst = append(dst, s...)
+		return append(dst, '`')
+	}
+	// Often called with big strings, so preallocate. If there's quoting,
+	// this is conservative but still helps a lot.
+	if cap(dst)-len(dst) < len(s) {
+		nBuf := make([]byte, len(dst), len(dst)+1+len(s)+1)
+		copy(nBuf, dst)
+		dst = nBuf
+	}
+	dst = append(dst, q.quote)
+	for len(s) > 0 {
+		// Copy the run of bytes that need no escaping at once.
+		if q.actions[s[0]] == quoteLiteral {
+			n := q.index(s)
+			if n < 0 {
+				dst = append(dst, s...)
+				break
+			}
+			dst = append(dst, s[:n]...)
+			s = s[n:]
+		}
+		switch c := s[0]; q.actions[c] {
+		case quoteHex:
+			dst = q.appendByte(dst, c)
+			s = s[1:]
+		case quoteRune:
+			r, width := utf8.DecodeRuneInString(s)
+			dst = q.appendRune(dst, r, s[:width])
+			s = s[width:]
+		default:
+			dst = append(dst, '\\', q.actions[c])
+			s = s[1:]
+		}
+	}
+	return append(dst, q.quote)
+}
+
+// index returns the index of the first byte of s that is not copied as
+// is, or -1.
+func (q *Quoter) index(s string) int {
+	if q.class != nil {
+		return q.class.Index(s)
+	}
+	return quoting.IndexEscape(s, q.quote)
+}
+
+// appendByte appends the escape of the ASCII byte c.
+func (q *Quoter) appendByte(dst []byte, c byte) []byte {
+	if q.unicodeBytes {
+		return append(dst, '\\', 'u', '0', '0', lowerhex[c>>4], lowerhex[c&0xF])
+	}
+	return append(dst, '\\', 'x', lowerhex[c>>4], lowerhex[c&0xF])
+}
+
+// appendRune appends the non-ASCII rune r, encoded in s, as is or escaped.
+func (q *Quoter) appendRune(dst []byte, r rune, s string) []byte {
+	switch {
+	case r == utf8.RuneError && len(s) == 1:
+		return append(dst, '\\', 'x', lowerhex[s[0]>>4], lowerhex[s[0]&0xF])
+	case !q.ascii && (IsPrint(r) || q.graphic && isInGraphicList(r)):
+		return append(dst, s...)
+	case r < 0x10000:
+		dst = append(dst, `\u`...)
+		for s := 12; s >= 0; s -= 4 {
+			dst = append(dst, lowerhex[r>>uint(s)&0xF])
+		}
+	default:
+		dst = append(dst, `\U`...)
+		for s := 28; s >= 0; s -= 4 {
+			dst = append(dst, lowerhex[r>>uint(s)&0xF])
+		}
+	}
+	return dst
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse_test
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mshafiee/fastparse"
+)
+
+var quoterTests = []struct {
+	opts    fastparse.QuoterOptions
+	in, out string
+}{
+	{fastparse.QuoterOptions{}, "say \"hi\"\n", `"say \"hi\"\n"`},
+	{fastparse.QuoterOptions{Quote: '\''}, `it's "ok"`, `'it\'s "ok"'`},
+	{fastparse.QuoterOptions{Quote: '|'}, "a|b", `|a\|b|`},
+	{fastparse.QuoterOptions{ASCII: true}, "café \x1b", `"caf\u00e9 \x1b"`},
+	{fastparse.QuoterOptions{UnicodeBytes: true}, "\x00\x1b\x7f\n\xff", `"\u0000\u001b\u007f\n\xff"`},
+	{fastparse.QuoterOptions{Escape: "$"}, "cost: $5", `"cost: \x245"`},
+	{fastparse.QuoterOptions{Escape: "$", UnicodeBytes: true}, "$HOME", `"\u0024HOME"`},
+	{fastparse.QuoterOptions{Escape: "${}"}, strings.Repeat("x", 40) + "${v}", `"` + strings.Repeat("x", 40) + `\x24\x7bv\x7d"`},
+	{fastparse.QuoterOptions{Escape: "\n\"\\"}, "a\n\"\\", `"a\n\"\\"`},
+	{fastparse.QuoterOptions{Backquote: true}, `C:\dir "x"`, "`C:\\dir \"x\"`"},
+	{fastparse.QuoterOptions{Backquote: true}, "two\nlines", `"two\nlines"`},
+	{fastparse.QuoterOptions{Backquote: true}, "a`b", "\"a`b\""},
+	{fastparse.QuoterOptions{ASCII: true, Escape: "#"}, "#\U0001F680 \xe2", `"\x23\U0001f680 \xe2"`},
+}
+
+func TestQuoter(t *testing.T) {
+	for _, tt := range quoterTests {
+		q := fastparse.NewQuoter(tt.opts)
+		if out := q.Quote(tt.in); out != tt.out {
+			t.Errorf("NewQuoter(%+v).Quote(%q) = %s, want %s", tt.opts, tt.in, out, tt.out)
+		}
+		if out := q.Append([]byte("x"), tt.in); string(out) != "x"+tt.out {
+			t.Errorf("NewQuoter(%+v).Append(%q) = %s, want %s", tt.opts, tt.in, out[1:], tt.out)
+		}
+	}
+}
+
+func TestQuoterPanics(t *testing.T) {
+	for _, opts := range []fastparse.QuoterOptions{
+		{Quote: '\\'},
+		{Quote: '\n'},
+		{Quote: 0x80},
+		{Escape: "é"},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewQuoter(%+v) did not panic", opts)
+				}
+			}()
+			fastparse.NewQuoter(opts)
+		}()
+	}
+}
+
+// TestQuoterPresets checks the presets against strconv on strings whose
+// non-ASCII characters are printable under every Unicode version.
+func TestQuoterPresets(t *testing.T) {
+	pieces := []string{
+		"a", "the quick brown fox jumps over the lazy dog", `"`, `\`, "'", "`", "\n", "\t", "\x00", "\x7f",
+		"é", "世界", "\U0001F680", "\xff", "\xe2\x82", "\ufffd",
+	}
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 5000; n++ {
+		var b strings.Builder
+		for k := r.Intn(16); k > 0; k-- {
+			b.WriteString(pieces[r.Intn(len(pieces))])
+		}
+		s := b.String()
+		for _, tt := range []struct {
+			name string
+			q    *fastparse.Quoter
+			want string
+		}{
+			{"GoQuoter", fastparse.GoQuoter(), strconv.Quote(s)},
+			{"ASCIIQuoter", fastparse.ASCIIQuoter(), strconv.QuoteToASCII(s)},
+			{"GraphicQuoter", fastparse.GraphicQuoter(), strconv.QuoteToGraphic(s)},
+		} {
+			if got := tt.q.Quote(s); got != tt.want {
+				t.Fatalf("%s.Quote(%q) = %s, want %s", tt.name, s, got, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,502 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// A Format is a compiled scanning format, as used by [Scan]. It is safe
+// for concurrent use.
+//
+// A format is literal text, white space and verbs. Literal text must
+// match the input exactly. A run of white space matches any run of white
+// space in the input, including none. Each verb first skips white space
+// and then scans one value into the next argument:
+//
+//	%d %x %o %b   an integer in base 10, 16, 8 or 2, by ParseInt or ParseUint
+//	%f %g %e      a float, by ParseFloat; %F %G %E are the same
+//	%s            a run of non-space bytes
+//	%q            a quoted string, as understood by QuotedPrefix and Unquote
+//	%v            an integer as for ParseInt with base 0, a float, a bool
+//	              by ParseBool, or a string as for %s, by the argument's type
+//	%%            a literal percent sign
+//
+// Integers go into pointers to the integer types, floats into *float32
+// and *float64, bools into *bool, and strings into *string and *[]byte.
+// Widths and the other verbs of fmt are not supported. Unlike fmt's, a
+// newline is white space like any other, and a format does not consume
+// the input after its last directive.
+type Format struct {
+	format string
+	ops    []scanOp
+	nargs  int
+}
+
+// A scanOpKind is the kind of a directive of a Format.
+type scanOpKind uint8
+
+const (
+	scanLiteral scanOpKind = iota
+	scanSpace
+	scanInt
+	scanFloat
+	scanString
+	scanQuoted
+	scanValue
+)
+
+// A scanOp is a directive of a Format: literal text, white space, or a
+// verb.
+type scanOp struct {
+	kind scanOpKind
+	text string // the literal text, or the verb with its percent sign
+	base int    // for scanInt
+}
+
+// CompileFormat parses a scanning format and returns a Format that
+// scans input with it. See [Format] for the syntax.
+func CompileFormat(format string) (*Format, error) {
+	f := &Format{format: format}
+	for i := 0; i < len(format); {
+		j := i + 1
+		switch c := format[i]; {
+		case isScanSpace(c):
+			for j < len(format) && isScanSpace(format[j]) {
+				j++
+			}
+			f.ops = append(f.ops, scanOp{kind: scanSpace, text: format[i:j]})
+		case c == '%':
+			if j == len(format) {
+				return nil, errors.New("fastparse: format " + QuoteToASCII(format) + " ends in %")
+			}
+			_, size := utf8.DecodeRuneInString(format[j:])
+			j += size
+			op := scanOp{text: format[i:j]}
+			switch format[i+1] {
+			case '%':
+				f.addLiteral("%")
+				i = j
+				continue
+			case 'd':
+				op.kind, op.base = scanInt, 10
+			case 'x', 'X':
+				op.kind, op.base = scanInt, 16
+			case 'o':
+				op.kind, op.base = scanInt, 8
+			case 'b':
+				op.kind, op.base = scanInt, 2
+			case 'e', 'E', 'f', 'F', 'g', 'G':
+				op.kind = scanFloat
+			case 's':
+				op.kind = scanString
+			case 'q':
+				op.kind = scanQuoted
+			case 'v':
+				op.kind = scanValue
+			default:
+				return nil, errors.New("fastparse: format " + QuoteToASCII(format) + ": unsupported verb " + QuoteToASCII(op.text))
+			}
+			f.ops = append(f.ops, op)
+			f.nargs++
+		default:
+			for j < len(format) && format[j] != '%' && !isScanSpace(format[j]) {
+				j++
+			}
+			f.addLiteral(format[i:j])
+		}
+		i = j
+	}
+	return f, nil
+}
+
+// MustCompileFormat is like [CompileFormat] but panics if the format is
+// invalid.
+func MustCompileFormat(format string) *Format {
+	f, err := CompileFormat(format)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// addLiteral appends literal text to f, joining it to literal text just
+// before it.
+func (f *Format) addLiteral(text string) {
+	if n := len(f.ops); n > 0 && f.ops[n-1].kind == scanLiteral {
+		f.ops[n-1].text += text
+		return
+	}
+	f.ops = append(f.ops, scanOp{kind: scanLiteral, text: text})
+}
+
+// String returns the source text of f.
+func (f *Format) String() string { return f.format }
+
+// A ScanError reports where input did not match a Format.
+type ScanError struct {
+	Offset    int    // byte offset in the input where the directive was matched
+	Arg       int    // index of the verb's argument, or -1 for literal text
+	Directive string // the verb, such as "%d", or the literal text
+	Err       error  // ErrSyntax, io.ErrUnexpectedEOF, or the parser's error, such as a *NumError
+}
+
+func (e *ScanError) Error() string {
+	s := "fastparse.Scan: "
+	if e.Arg >= 0 {
+		s += "argument " + Itoa(e.Arg) + " "
+	}
+	return s + QuoteToASCII(e.Directive) + " at offset " + Itoa(e.Offset) + ": " + e.Err.Error()
+}
+
+func (e *ScanError) Unwrap() error { return e.Err }
+
+// Scan scans s with f, storing the values of its verbs in args, which
+// must be one pointer for each verb. It returns the number of values
+// stored. If s does not match f, Scan stops and returns a *[ScanError];
+// the values stored before it keep their new values.
+//
+// Scan does not allocate, except to return an error, to store into a
+// *[]byte without the capacity for its value, and to store a %q value
+// with escape sequences into a *string. Other strings stored into a
+// *string are substrings of s.
+func (f *Format) Scan(s string, args ...any) (n int, err error) {
+	if len(args) != f.nargs {
+		return 0, errors.New("fastparse: format " + QuoteToASCII(f.format) + " has " + Itoa(f.nargs) +
+			" verbs, but Scan got " + Itoa(len(args)) + " arguments")
+	}
+	i := 0
+	for k := range f.ops {
+		op := &f.ops[k]
+		switch op.kind {
+		case scanSpace:
+			i = skipScanSpace(s, i)
+			continue
+		case scanLiteral:
+			if !strings.HasPrefix(s[i:], op.text) {
+				err := ErrSyntax
+				if strings.HasPrefix(op.text, s[i:]) {
+					err = io.ErrUnexpectedEOF
+				}
+				return n, &ScanError{i, -1, op.text, err}
+			}
+			i += len(op.text)
+			continue
+		}
+		i = skipScanSpace(s, i)
+		if i == len(s) {
+			return n, &ScanError{i, n, op.text, io.ErrUnexpectedEOF}
+		}
+		m, err := op.scan(s[i:], args[n])
+		if err != nil {
+			return n, &ScanError{i, n, op.text, err}
+		}
+		i += m
+		n++
+	}
+	return n, nil
+}
+
+var scanFormats sync.Map // format string → *Format
+
+// Scan scans s according to format, a fast replacement for fmt.Sscanf
+// with the subset of its syntax described at [Format]. It compiles each
+// format once and caches it; a program that builds formats at run time
+// should compile them with [CompileFormat] instead.
+//
+//	var x int
+//	var y float64
+//	var name string
+//	n, err := fastparse.Scan(`x=12 y=0.5 name="a b"`, "x=%d y=%f name=%q", &x, &y, &name)
+func Scan(s, format string, args ...any) (n int, err error) {
+	f, ok := scanFormats.Load(format)
+	if !ok {
+		cf, err := CompileFormat(format)
+		if err != nil {
+			return 0, err
+		}
+		f, _ = scanFormats.LoadOrStore(format, cf)
+	}
+	return f.(*Format).Scan(s, args...)
+}
+
+// errScanType reports a verb that does not scan into its argument's type.
+var errScanType = errors.New("wrong argument type")
+
+// scan scans the value of the verb op from the nonempty s into arg and
+// returns the number of bytes it used. It leaves arg unchanged on error.
+func (op *scanOp) scan(s string, arg any) (int, error) {
+	m, err := op.store(s, arg)
+	if err == errScanType {
+		t := "nil"
+		if arg != nil {
+			t = reflect.TypeOf(arg).String()
+		}
+		return 0, errors.New("cannot scan " + op.text + " into " + t)
+	}
+	return m, err
+}
+
+func (op *scanOp) store(s string, arg any) (int, error) {
+	switch p := arg.(type) {
+	case *int:
+		v, m, err := op.scanInt(s, 0)
+		if err == nil {
+			*p = int(v)
+		}
+		return m, err
+	case *int8:
+		v, m, err := op.scanInt(s, 8)
+		if err == nil {
+			*p = int8(v)
+		}
+		return m, err
+	case *int16:
+		v, m, err := op.scanInt(s, 16)
+		if err == nil {
+			*p = int16(v)
+		}
+		return m, err
+	case *int32:
+		v, m, err := op.scanInt(s, 32)
+		if err == nil {
+			*p = int32(v)
+		}
+		return m, err
+	case *int64:
+		v, m, err := op.scanInt(s, 64)
+		if err == nil {
+			*p = v
+		}
+		return m, err
+	case *uint:
+		v, m, err := op.scanUint(s, 0)
+		if err == nil {
+			*p = uint(v)
+		}
+		return m, err
+	case *uint8:
+		v, m, err := op.scanUint(s, 8)
+		if err == nil {
+			*p = uint8(v)
+		}
+		return m, err
+	case *uint16:
+		v, m, err := op.scanUint(s, 16)
+		if err == nil {
+			*p = uint16(v)
+		}
+		return m, err
+	case *uint32:
+		v, m, err := op.scanUint(s, 32)
+		if err == nil {
+			*p = uint32(v)
+		}
+		return m, err
+	case *uint64:
+		v, m, err := op.scanUint(s, 64)
+		if err == nil {
+			*p = v
+		}
+		return m, err
+	case *float32:
+		v, m, err := op.scanFloat(s, 32)
+		if err == nil {
+			*p = float32(v)
+		}
+		return m, err
+	case *float64:
+		v, m, err := op.scanFloat(s, 64)
+		if err == nil {
+			*p = v
+		}
+		return m, err
+	case *bool:
+		if op.kind != scanValue {
+			break
+		}
+		m := 0
+		for m < len(s) && isScanWordByte(s[m]) {
+			m++
+		}
+		v, st := TryParseBool(s[:m])
+		if st != StatusOK {
+			return 0, st.Err("ParseBool", scanWord(s))
+		}
+		*p = v
+		return m, nil
+	case *string:
+		switch op.kind {
+		case scanString, scanValue:
+			m := len(scanWord(s))
+			*p = s[:m]
+			return m, nil
+		case scanQuoted:
+			v, rem, err := unquote(s, true)
+			if err != nil {
+				return 0, syntaxError("Unquote", scanWord(s))
+			}
+			*p = v
+			return len(s) - len(rem), nil
+		}
+	case *[]byte:
+		switch op.kind {
+		case scanString, scanValue:
+			w := scanWord(s)
+			*p = append((*p)[:0], w...)
+			return len(w), nil
+		case scanQuoted:
+			lit, rem, err := unquote(s, false)
+			if err != nil {
+				return 0, syntaxError("Unquote", scanWord(s))
+			}
+			*p, _ = AppendUnquote((*p)[:0], lit) // lit is valid
+			return len(s) - len(rem), nil
+		}
+	}
+	return 0, errScanType
+}
+
+// scanInt scans the integer prefix of s for the verb op by TryParseInt.
+func (op *scanOp) scanInt(s string, bitSize int) (int64, int, error) {
+	base, ok := op.intBase()
+	if !ok {
+		return 0, 0, errScanType
+	}
+	m := scanIntLen(s, base, true)
+	v, st := TryParseInt(s[:m], base, bitSize)
+	if st != StatusOK {
+		return 0, 0, st.Err(fnParseInt, scanNum(s, m))
+	}
+	return v, m, nil
+}
+
+// scanUint is like scanInt but for an unsigned integer, without a sign.
+func (op *scanOp) scanUint(s string, bitSize int) (uint64, int, error) {
+	base, ok := op.intBase()
+	if !ok {
+		return 0, 0, errScanType
+	}
+	m := scanIntLen(s, base, false)
+	v, st := TryParseUint(s[:m], base, bitSize)
+	if st != StatusOK {
+		return 0, 0, st.Err(fnParseUint, scanNum(s, m))
+	}
+	return v, m, nil
+}
+
+// intBase returns the base in which the verb op scans integers, and
+// whether it scans integers at all.
+func (op *scanOp) intBase() (int, bool) {
+	switch op.kind {
+	case scanInt:
+		return op.base, true
+	case scanValue:
+		return 0, true
+	}
+	return 0, false
+}
+
+// scanFloat scans the float prefix of s by the parser behind ParseFloat.
+func (op *scanOp) scanFloat(s string, bitSize int) (float64, int, error) {
+	if op.kind != scanFloat && op.kind != scanValue {
+		return 0, 0, errScanType
+	}
+	f, m, st := atof(s, bitSize)
+	if st != StatusOK {
+		return 0, 0, st.Err(fnParseFloat, scanNum(s, m))
+	}
+	return f, m, nil
+}
+
+// scanIntLen returns the length of the integer at the start of s in the
+// given base, or in base 0 with its prefix and underscores, including a
+// sign if signed is set. The parser checks the digits it covers.
+func scanIntLen(s string, base int, signed bool) int {
+	i := 0
+	if signed && i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	b := base
+	if base == 0 {
+		b = 10
+		if i < len(s) && s[i] == '0' {
+			b = 8
+			if i+1 < len(s) {
+				switch lower(s[i+1]) {
+				case 'b':
+					b, i = 2, i+2
+				case 'o':
+					i += 2
+				case 'x':
+					b, i = 16, i+2
+				}
+			}
+		}
+	}
+	for ; i < len(s); i++ {
+		c := s[i]
+		var d int
+		switch {
+		case isDigitFast(c):
+			d = digitValue(c)
+		case isHexDigitFast(c):
+			d = hexDigitValue(c)
+		case c == '_' && base == 0:
+			continue
+		default:
+			return i
+		}
+		if d >= b {
+			return i
+		}
+	}
+	return i
+}
+
+// scanNum returns the text to report in a parser's error for the value
+// of length m at the start of s: the value itself, or the word it fails
+// to start.
+func scanNum(s string, m int) string {
+	if m > 0 {
+		return s[:m]
+	}
+	return scanWord(s)
+}
+
+// scanWord returns the run of non-space bytes at the start of s.
+func scanWord(s string) string {
+	i := 0
+	for i < len(s) && !isScanSpace(s[i]) {
+		i++
+	}
+	return s[:i]
+}
+
+func isScanWordByte(c byte) bool {
+	return isDigitFast(c) || lower(c)-'a' < 26
+}
+
+func isScanSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\v', '\f', '\r':
+		return true
+	}
+	return false
+}
+
+// skipScanSpace returns the index of the first byte of s at or after i
+// that is not white space.
+func skipScanSpace(s string, i int) int {
+	for i < len(s) && isScanSpace(s[i]) {
+		i++
+	}
+	return i
+}
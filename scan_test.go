@@ -0,0 +1,186 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	var (
+		x    int
+		y    float64
+		name string
+		hex  uint32
+		oct  int8
+		bin  uint8
+		f32  float32
+		v    int64
+		ok   bool
+		word []byte
+		raw  []byte
+	)
+	in := "x=-12 y=2.5e3 name=\"a \\\"b\\\"\" ff 17\t101 0x1p-2 0x_1F true\n abc `c d`%"
+	n, err := Scan(in, "x=%d y=%f name=%q %x %o %b %g %v %v %s %q%%",
+		&x, &y, &name, &hex, &oct, &bin, &f32, &v, &ok, &word, &raw)
+	if err != nil || n != 11 {
+		t.Fatalf("Scan = %d, %v", n, err)
+	}
+	if x != -12 || y != 2500 || name != `a "b"` || hex != 0xff || oct != 0o17 || bin != 5 ||
+		f32 != 0.25 || v != 0x1f || !ok || string(word) != "abc" || string(raw) != "c d" {
+		t.Errorf("Scan stored %d %v %q %x %o %b %v %d %t %q %q", x, y, name, hex, oct, bin, f32, v, ok, word, raw)
+	}
+}
+
+func TestScanMatchesSscanf(t *testing.T) {
+	tests := []struct{ in, format string }{
+		{"x=1 y=2.5", "x=%d y=%f"},
+		{"  42   -7", "%d %d"},
+		{"10:20", "%d:%d"},
+		{"ff 7", "%x %o"},
+		{"+3 1e-3", "%d %g"},
+		{"1,2", "%d,%d"},
+	}
+	for _, test := range tests {
+		var a, b, c, d any = new(int), new(int), new(int), new(int)
+		if strings.ContainsAny(test.format, "fg") {
+			b, d = new(float64), new(float64)
+		}
+		n, err := Scan(test.in, test.format, a, b)
+		wantN, wantErr := fmt.Sscanf(test.in, test.format, c, d)
+		if n != wantN || (err == nil) != (wantErr == nil) {
+			t.Errorf("Scan(%q, %q) = %d, %v; fmt.Sscanf gives %d, %v", test.in, test.format, n, err, wantN, wantErr)
+			continue
+		}
+		if got, want := fmt.Sprint(deref(a), deref(b)), fmt.Sprint(deref(c), deref(d)); got != want {
+			t.Errorf("Scan(%q, %q) stored %s; fmt.Sscanf stores %s", test.in, test.format, got, want)
+		}
+	}
+}
+
+func deref(p any) any {
+	switch p := p.(type) {
+	case *int:
+		return *p
+	case *float64:
+		return *p
+	}
+	return p
+}
+
+func TestScanErrors(t *testing.T) {
+	tests := []struct {
+		in, format string
+		n          int
+		arg        int
+		offset     int
+		err        error
+	}{
+		{"x=1 z=2", "x=%d y=%d", 1, -1, 4, ErrSyntax},
+		{"x=1 y= ", "x=%d y=%d", 1, 1, 7, io.ErrUnexpectedEOF},
+		{"x=1 y", "x=%d y=", 1, -1, 4, io.ErrUnexpectedEOF},
+		{"x=abc", "x=%d", 0, 0, 2, ErrSyntax},
+		{"300", "%v", 0, 0, 0, ErrRange},
+		{"-1", "%d", 0, 0, 0, ErrSyntax},
+		{"1e400", "%f", 0, 0, 0, ErrRange},
+		{`"abc`, "%q", 0, 0, 0, ErrSyntax},
+		{"maybe", "%v", 0, 0, 0, ErrSyntax},
+	}
+	for i, test := range tests {
+		var a, b any = new(int), new(int)
+		switch i {
+		case 4:
+			a = new(int8)
+		case 5:
+			a = new(uint)
+		case 6:
+			a = new(float64)
+		case 7:
+			a = new(string)
+		case 8:
+			a = new(bool)
+		}
+		args := []any{a, b}[:strings.Count(test.format, "%")]
+		n, err := Scan(test.in, test.format, args...)
+		var serr *ScanError
+		if n != test.n || !errors.As(err, &serr) || serr.Arg != test.arg || serr.Offset != test.offset || !errors.Is(err, test.err) {
+			t.Errorf("Scan(%q, %q) = %d, %v; want %d, argument %d at offset %d: %v",
+				test.in, test.format, n, err, test.n, test.arg, test.offset, test.err)
+		}
+	}
+
+	var x int
+	var s string
+	if _, err := Scan("1", "%s", &x); err == nil || !strings.Contains(err.Error(), `"%s" at offset 0: cannot scan %s into *int`) {
+		t.Errorf("Scan of %%s into *int: err = %v", err)
+	}
+	if _, err := Scan("1", "%d %d", &x); err == nil {
+		t.Errorf("Scan with too few arguments succeeded")
+	}
+	if _, err := Scan("1", "%d", &x, &s); err == nil {
+		t.Errorf("Scan with too many arguments succeeded")
+	}
+	x = 5
+	if _, err := Scan("99999999999999999999", "%d", &x); err == nil || x != 5 {
+		t.Errorf("Scan out of range: x = %d, err %v; want 5 unchanged and an error", x, err)
+	}
+	want := `fastparse.Scan: argument 0 "%d" at offset 2: strconv.ParseInt: parsing "abc": invalid syntax`
+	if _, err := Scan("x=abc", "x=%d", &x); err == nil || err.Error() != want {
+		t.Errorf("Scan error = %v\nwant %s", err, want)
+	}
+}
+
+func TestCompileFormat(t *testing.T) {
+	for _, format := range []string{"%", "x=%", "%5d", "%c", "%é"} {
+		if _, err := CompileFormat(format); err == nil {
+			t.Errorf("CompileFormat(%q) succeeded", format)
+		}
+	}
+	f := MustCompileFormat("a%%b \t%d")
+	if f.String() != "a%%b \t%d" || f.nargs != 1 || len(f.ops) != 3 || f.ops[0].text != "a%b" {
+		t.Errorf("CompileFormat = %+v", f)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustCompileFormat of an invalid format did not panic")
+		}
+	}()
+	MustCompileFormat("%z")
+}
+
+func TestScanAllocs(t *testing.T) {
+	const format = "id=%d x=%f y=%g mask=%x name=%s tag=%q ok=%v"
+	f := MustCompileFormat(format)
+	line := `id=12345 x=-0.5 y=1e30 mask=ff00 name=node-7 tag="abc" ok=true`
+	var (
+		id   int64
+		x    float64
+		y    float32
+		mask uint16
+		name string
+		ok   bool
+	)
+	tag := make([]byte, 0, 16)
+	for _, scan := range []func() (int, error){
+		func() (int, error) { return f.Scan(line, &id, &x, &y, &mask, &name, &tag, &ok) },
+		func() (int, error) { return Scan(line, format, &id, &x, &y, &mask, &name, &tag, &ok) },
+	} {
+		allocs := testing.AllocsPerRun(100, func() {
+			if _, err := scan(); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("Scan: %v allocations, want 0", allocs)
+		}
+	}
+	if id != 12345 || x != -0.5 || y != 1e30 || mask != 0xff00 || name != "node-7" || string(tag) != "abc" || !ok {
+		t.Errorf("Scan stored %d %v %v %x %q %q %t", id, x, y, mask, name, tag, ok)
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !fastparse_stats
+
+package fastparse
+
+// statsEnabled reports whether the parsers count their paths.
+// Build with -tags fastparse_stats to count them.
+const statsEnabled = false
+
+// stat is inlined to nothing, so the parsers pay nothing for counting.
+func stat(statCounter) {}
+
+func loadStats() (c [numStats]uint64) { return c }
+
+func resetStats() {}
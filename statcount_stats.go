@@ -0,0 +1,33 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build fastparse_stats
+
+package fastparse
+
+import "sync/atomic"
+
+// statsEnabled reports whether the parsers count their paths.
+// This build counts them, as selected by the fastparse_stats tag.
+const statsEnabled = true
+
+var statCounts [numStats]atomic.Uint64
+
+// stat counts one call taking the path c.
+func stat(c statCounter) {
+	statCounts[c].Add(1)
+}
+
+func loadStats() (c [numStats]uint64) {
+	for i := range statCounts {
+		c[i] = statCounts[i].Load()
+	}
+	return c
+}
+
+func resetStats() {
+	for i := range statCounts {
+		statCounts[i].Store(0)
+	}
+}
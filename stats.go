@@ -0,0 +1,178 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+// ParseStats counts which path of the float and integer parsers handled
+// each call. The counts are kept only when fastparse is built with
+// -tags fastparse_stats; otherwise counting compiles away and Stats
+// returns zeros.
+type ParseStats struct {
+	Enabled bool // built with the fastparse_stats tag
+	Float   FloatStats
+	Int     IntStats
+}
+
+// FloatStats counts the paths taken by ParseFloat.
+//
+// A ParseFloat(s, 64) call outside amd64 counts once in the tier that
+// returned its result, and in the misses of the tiers it fell through.
+// ParseFloat(s, 32), ParseComplex and the prefix parsers count in the
+// atof paths instead. TryParseFloat counts in the paths ParseFloat would
+// take, or the atof paths on amd64, but not in Calls, Syntax or Range.
+type FloatStats struct {
+	Calls uint64 // ParseFloat calls
+
+	// Stdlib counts calls delegated to strconv.ParseFloat, as every
+	// ParseFloat call is on amd64.
+	Stdlib uint64
+
+	// Tier 1, the direct conversion of inputs of up to 16 bytes.
+	Direct            uint64 // converted directly
+	DirectEiselLemire uint64 // parsed directly, converted by Eisel-Lemire
+	DirectMiss        uint64 // fell through to tier 2
+
+	// Tier 2, the simple parser for inputs the classifier accepts.
+	Simple            uint64 // converted by the simple parser
+	SimpleEiselLemire uint64 // parsed simply, converted by Eisel-Lemire
+	SimpleMiss        uint64 // fell through to tier 3
+	Complex           uint64 // rejected by the classifier
+
+	// Tier 3, the fast paths for hex floats and long decimals and then
+	// the full FSA parse, counted by how its result was converted.
+	Hex            uint64 // hex float fast path
+	LongDecimal    uint64 // long decimal fast path
+	FSASpecial     uint64 // Inf or NaN
+	FSAHex         uint64 // hex float
+	FSAShortcut    uint64 // zero, or out of range by its exponent alone
+	FSAEiselLemire uint64 // Eisel-Lemire
+	FSAExact       uint64 // exact float64 arithmetic
+	FSAExtended    uint64 // rounded mantissa with the power-of-ten table
+	FSADecimal     uint64 // multiprecision decimal
+
+	// The strconv-derived atof paths.
+	AtofSpecial     uint64 // Inf or NaN
+	AtofHex         uint64 // hex float
+	AtofExact       uint64 // exact float arithmetic
+	AtofEiselLemire uint64 // Eisel-Lemire
+	AtofDecimal     uint64 // multiprecision decimal
+
+	// Rejected ParseFloat calls, by error.
+	Syntax uint64
+	Range  uint64
+}
+
+// IntStats counts the paths taken by ParseInt, ParseUint and Atoi.
+// ParseInt and the slow path of Atoi call ParseUint and count there too.
+type IntStats struct {
+	AtoiFast uint64 // Atoi's path for integers that cannot overflow
+
+	// ParseUint calls reaching the digit loop, by base.
+	Decimal     uint64 // base 10, given or implied by base 0
+	Prefixed    uint64 // base 0 with a 0b, 0o, 0x or 0 prefix
+	OtherBase   uint64 // any other base from 2 to 36
+	Underscores uint64 // base 0 inputs with underscores to validate
+
+	// Rejected calls, by error.
+	Syntax         uint64
+	Range          uint64
+	InvalidBase    uint64
+	InvalidBitSize uint64
+}
+
+// A statCounter indexes the counters behind ParseStats.
+type statCounter int
+
+const (
+	statFloatCalls statCounter = iota
+	statFloatStdlib
+	statFloatDirect
+	statFloatDirectEiselLemire
+	statFloatDirectMiss
+	statFloatSimple
+	statFloatSimpleEiselLemire
+	statFloatSimpleMiss
+	statFloatComplex
+	statFloatHex
+	statFloatLongDecimal
+	statFloatFSASpecial
+	statFloatFSAHex
+	statFloatFSAShortcut
+	statFloatFSAEiselLemire
+	statFloatFSAExact
+	statFloatFSAExtended
+	statFloatFSADecimal
+	statFloatAtofSpecial
+	statFloatAtofHex
+	statFloatAtofExact
+	statFloatAtofEiselLemire
+	statFloatAtofDecimal
+	statFloatSyntax
+	statFloatRange
+
+	statIntAtoiFast
+	statIntDecimal
+	statIntPrefixed
+	statIntOtherBase
+	statIntUnderscores
+	statIntSyntax
+	statIntRange
+	statIntInvalidBase
+	statIntInvalidBitSize
+
+	numStats
+)
+
+// Stats returns the parser statistics counted since the program started
+// or ResetStats was last called. The counters are updated atomically but
+// not read as a set, so calls concurrent with Stats may be partly counted.
+func Stats() ParseStats {
+	c := loadStats()
+	return ParseStats{
+		Enabled: statsEnabled,
+		Float: FloatStats{
+			Calls:             c[statFloatCalls],
+			Stdlib:            c[statFloatStdlib],
+			Direct:            c[statFloatDirect],
+			DirectEiselLemire: c[statFloatDirectEiselLemire],
+			DirectMiss:        c[statFloatDirectMiss],
+			Simple:            c[statFloatSimple],
+			SimpleEiselLemire: c[statFloatSimpleEiselLemire],
+			SimpleMiss:        c[statFloatSimpleMiss],
+			Complex:           c[statFloatComplex],
+			Hex:               c[statFloatHex],
+			LongDecimal:       c[statFloatLongDecimal],
+			FSASpecial:        c[statFloatFSASpecial],
+			FSAHex:            c[statFloatFSAHex],
+			FSAShortcut:       c[statFloatFSAShortcut],
+			FSAEiselLemire:    c[statFloatFSAEiselLemire],
+			FSAExact:          c[statFloatFSAExact],
+			FSAExtended:       c[statFloatFSAExtended],
+			FSADecimal:        c[statFloatFSADecimal],
+			AtofSpecial:       c[statFloatAtofSpecial],
+			AtofHex:           c[statFloatAtofHex],
+			AtofExact:         c[statFloatAtofExact],
+			AtofEiselLemire:   c[statFloatAtofEiselLemire],
+			AtofDecimal:       c[statFloatAtofDecimal],
+			Syntax:            c[statFloatSyntax],
+			Range:             c[statFloatRange],
+		},
+		Int: IntStats{
+			AtoiFast:       c[statIntAtoiFast],
+			Decimal:        c[statIntDecimal],
+			Prefixed:       c[statIntPrefixed],
+			OtherBase:      c[statIntOtherBase],
+			Underscores:    c[statIntUnderscores],
+			Syntax:         c[statIntSyntax],
+			Range:          c[statIntRange],
+			InvalidBase:    c[statIntInvalidBase],
+			InvalidBitSize: c[statIntInvalidBitSize],
+		},
+	}
+}
+
+// ResetStats sets every parser statistic to zero.
+func ResetStats() {
+	resetStats()
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"runtime"
+	"testing"
+)
+
+// TestStats checks the counters, when built with -tags fastparse_stats,
+// and that they stay zero otherwise.
+func TestStats(t *testing.T) {
+	ResetStats()
+	defer ResetStats()
+
+	for _, s := range []string{"1.5", "123456789012345678e-5", "0x1p-2", "1_0.5", "inf", "1e400", "0"} {
+		parseFloatGeneric(s)
+	}
+	ParseFloat("1.5", 64)
+	ParseFloat("x", 64)
+	ParseFloat("1e400", 64)
+	ParseFloat("3.25", 32)
+	ParseFloat("0x1.8p1", 32)
+	ParseFloat("nan", 32)
+
+	Atoi("-42")
+	Atoi("12345678901234567890")
+	ParseInt("-0x_1f", 0, 64)
+	ParseInt("200", 10, 8)
+	ParseUint("zz", 36, 64)
+	ParseUint("12a", 10, 64)
+	ParseUint("1", 1, 64)
+	ParseUint("1", 10, 65)
+
+	got := Stats()
+	if !statsEnabled {
+		if got != (ParseStats{}) {
+			t.Fatalf("Stats() = %+v without the fastparse_stats tag, want zero", got)
+		}
+		return
+	}
+	if !got.Enabled {
+		t.Errorf("Stats().Enabled = false")
+	}
+
+	f := got.Float
+	if f.Calls != 6 {
+		t.Errorf("Float.Calls = %d, want 6", f.Calls)
+	}
+	if runtime.GOARCH == "amd64" {
+		if f.Stdlib != 6 {
+			t.Errorf("Float.Stdlib = %d, want 6", f.Stdlib)
+		}
+	}
+	if f.Direct == 0 {
+		t.Errorf("Float.Direct = 0; %q takes the direct tier", "1.5")
+	}
+	if f.Complex == 0 || f.FSASpecial == 0 || f.FSAShortcut == 0 {
+		t.Errorf("Float: Complex %d, FSASpecial %d, FSAShortcut %d; want all nonzero", f.Complex, f.FSASpecial, f.FSAShortcut)
+	}
+	if f.Hex+f.FSAHex == 0 {
+		t.Errorf("Float: Hex and FSAHex are 0; %q is a hex float", "0x1p-2")
+	}
+	if f.Syntax != 1 || f.Range != 1 {
+		t.Errorf("Float: Syntax %d, Range %d; want 1, 1", f.Syntax, f.Range)
+	}
+	if runtime.GOARCH != "amd64" {
+		if f.AtofExact != 1 || f.AtofHex != 1 || f.AtofSpecial != 1 {
+			t.Errorf("Float: AtofExact %d, AtofHex %d, AtofSpecial %d; want 1, 1, 1", f.AtofExact, f.AtofHex, f.AtofSpecial)
+		}
+	}
+
+	want := IntStats{
+		AtoiFast:       1,
+		Decimal:        3, // Atoi's slow path, ParseInt("200") and ParseUint("12a")
+		Prefixed:       1,
+		OtherBase:      1,
+		Underscores:    1,
+		Syntax:         1,
+		Range:          2,
+		InvalidBase:    1,
+		InvalidBitSize: 1,
+	}
+	if got.Int != want {
+		t.Errorf("Int = %+v\nwant %+v", got.Int, want)
+	}
+
+	ResetStats()
+	if got := Stats(); got != (ParseStats{Enabled: true}) {
+		t.Errorf("after ResetStats, Stats() = %+v", got)
+	}
+}
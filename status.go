@@ -0,0 +1,89 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"errors"
+	"strings"
+)
+
+// A Status reports the outcome of a TryParse function. Unlike the error
+// of the corresponding Parse function, it costs nothing to return, so
+// the TryParse functions never allocate, even when the input is invalid.
+type Status uint8
+
+const (
+	StatusOK             Status = iota // the input was parsed
+	StatusSyntax                       // the input is malformed, as for ErrSyntax
+	StatusRange                        // the value is out of range, as for ErrRange
+	StatusInvalidBase                  // the base argument is invalid
+	StatusInvalidBitSize               // the bitSize argument is invalid
+)
+
+var statusText = [...]string{
+	StatusOK:             "ok",
+	StatusSyntax:         "invalid syntax",
+	StatusRange:          "value out of range",
+	StatusInvalidBase:    "invalid base",
+	StatusInvalidBitSize: "invalid bit size",
+}
+
+// String returns a description of st matching the text of its error.
+func (st Status) String() string {
+	if int(st) < len(statusText) {
+		return statusText[st]
+	}
+	return "Status(" + Itoa(int(st)) + ")"
+}
+
+var (
+	errInvalidBase    = errors.New(statusText[StatusInvalidBase])
+	errInvalidBitSize = errors.New(statusText[StatusInvalidBitSize])
+)
+
+// Err returns the error the Parse function named fn would return for the
+// input s, or nil if st is StatusOK. The error is a [*NumError] with
+// Func fn, Num s and Err [ErrSyntax] or [ErrRange] for those statuses, so
+// that errors.Is matches it as it would the Parse function's error.
+// Not knowing the offending argument, Err leaves it out of the message
+// for StatusInvalidBase and StatusInvalidBitSize.
+//
+// Err lets a caller of the TryParse functions build an error only for the
+// inputs it reports:
+//
+//	v, st := fastparse.TryParseInt(s, 10, 64)
+//	if st != fastparse.StatusOK {
+//		return st.Err("ParseInt", s)
+//	}
+func (st Status) Err(fn, s string) error {
+	switch st {
+	case StatusOK:
+		return nil
+	case StatusSyntax:
+		return syntaxError(fn, s)
+	case StatusRange:
+		return rangeError(fn, s)
+	case StatusInvalidBase:
+		return &NumError{fn, strings.Clone(s), errInvalidBase}
+	case StatusInvalidBitSize:
+		return &NumError{fn, strings.Clone(s), errInvalidBitSize}
+	}
+	return &NumError{fn, strings.Clone(s), errors.New(st.String())}
+}
+
+// numError returns the error the Parse function fn returns for s when
+// its kernel reports st, which must not be StatusOK. The base and bitSize
+// fn was called with complete the messages of the argument errors.
+func (st Status) numError(fn, s string, base, bitSize int) *NumError {
+	switch st {
+	case StatusRange:
+		return rangeError(fn, s)
+	case StatusInvalidBase:
+		return baseError(fn, s, base)
+	case StatusInvalidBitSize:
+		return bitSizeError(fn, s, bitSize)
+	}
+	return syntaxError(fn, s)
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+// errStatus returns the Status matching an error from a Parse function.
+func errStatus(err error) Status {
+	if err == nil {
+		return StatusOK
+	}
+	switch e := err.(*NumError).Err; {
+	case e == ErrSyntax:
+		return StatusSyntax
+	case e == ErrRange:
+		return StatusRange
+	case strings.HasPrefix(e.Error(), "invalid base"):
+		return StatusInvalidBase
+	case strings.HasPrefix(e.Error(), "invalid bit size"):
+		return StatusInvalidBitSize
+	}
+	return 255
+}
+
+func TestTryParseInt(t *testing.T) {
+	type intCase struct {
+		in            string
+		base, bitSize int
+	}
+	var cases []intCase
+	for _, test := range parseUint64Tests {
+		cases = append(cases, intCase{test.in, 10, 64})
+	}
+	for _, test := range parseUint64BaseTests {
+		cases = append(cases, intCase{test.in, test.base, 64})
+	}
+	for _, test := range parseInt64Tests {
+		cases = append(cases, intCase{test.in, 10, 64}, intCase{test.in, 10, 0})
+	}
+	for _, test := range parseInt64BaseTests {
+		cases = append(cases, intCase{test.in, test.base, 64})
+	}
+	for _, test := range parseInt32Tests {
+		cases = append(cases, intCase{test.in, 10, 32}, intCase{test.in, 0, 8})
+	}
+	for _, test := range parseBaseTests {
+		cases = append(cases, intCase{"0", test.arg, 0})
+	}
+	for _, test := range parseBitSizeTests {
+		cases = append(cases, intCase{"0", 0, test.arg})
+	}
+
+	for _, c := range cases {
+		want, err := ParseUint(c.in, c.base, c.bitSize)
+		got, st := TryParseUint(c.in, c.base, c.bitSize)
+		if got != want || st != errStatus(err) {
+			t.Errorf("TryParseUint(%q, %d, %d) = %d, %v; ParseUint gives %d, %v",
+				c.in, c.base, c.bitSize, got, st, want, err)
+		}
+		if got, st2 := TryParseUintBytes([]byte(c.in), c.base, c.bitSize); got != want || st2 != st {
+			t.Errorf("TryParseUintBytes(%q, %d, %d) = %d, %v; want %d, %v",
+				c.in, c.base, c.bitSize, got, st2, want, st)
+		}
+
+		wantI, err := ParseInt(c.in, c.base, c.bitSize)
+		gotI, st := TryParseInt(c.in, c.base, c.bitSize)
+		if gotI != wantI || st != errStatus(err) {
+			t.Errorf("TryParseInt(%q, %d, %d) = %d, %v; ParseInt gives %d, %v",
+				c.in, c.base, c.bitSize, gotI, st, wantI, err)
+		}
+		if gotI, st2 := TryParseIntBytes([]byte(c.in), c.base, c.bitSize); gotI != wantI || st2 != st {
+			t.Errorf("TryParseIntBytes(%q, %d, %d) = %d, %v; want %d, %v",
+				c.in, c.base, c.bitSize, gotI, st2, wantI, st)
+		}
+	}
+}
+
+func TestTryParseFloat(t *testing.T) {
+	initAtof()
+	check := func(in string, bitSize int) {
+		t.Helper()
+		want, err := ParseFloat(in, bitSize)
+		got, st := TryParseFloat(in, bitSize)
+		if math.Float64bits(got) != math.Float64bits(want) && !(math.IsNaN(got) && math.IsNaN(want)) ||
+			st != errStatus(err) {
+			t.Errorf("TryParseFloat(%q, %d) = %v, %v; ParseFloat gives %v, %v", in, bitSize, got, st, want, err)
+		}
+		if got2, st2 := TryParseFloatBytes([]byte(in), bitSize); math.Float64bits(got2) != math.Float64bits(got) || st2 != st {
+			t.Errorf("TryParseFloatBytes(%q, %d) = %v, %v; want %v, %v", in, bitSize, got2, st2, got, st)
+		}
+	}
+	for _, test := range atoftests {
+		check(test.in, 64)
+		check(test.in, 32)
+	}
+	for _, test := range atof32tests {
+		check(test.in, 32)
+	}
+	// More significant digits than the decimal fallback holds; the
+	// dropped integer digits still count towards the exponent.
+	long := "7156220717830711" + strings.Repeat("3", 835)
+	for _, in := range []string{long + "e-534", long + ".25e-534", long + "e-560", "-" + long + "e-600", "0." + long + "e-500"} {
+		check(in, 64)
+		check(in, 32)
+	}
+}
+
+func TestTryParseBool(t *testing.T) {
+	for _, test := range atobtests {
+		want, err := ParseBool(test.in)
+		got, st := TryParseBool(test.in)
+		if got != want || st != errStatus(err) {
+			t.Errorf("TryParseBool(%q) = %t, %v; ParseBool gives %t, %v", test.in, got, st, want, err)
+		}
+		if got, st2 := TryParseBoolBytes([]byte(test.in)); got != want || st2 != st {
+			t.Errorf("TryParseBoolBytes(%q) = %t, %v; want %t, %v", test.in, got, st2, want, st)
+		}
+	}
+}
+
+func TestStatusErr(t *testing.T) {
+	tests := []struct {
+		st   Status
+		want string
+		is   error
+	}{
+		{StatusSyntax, `strconv.ParseInt: parsing "1x": invalid syntax`, ErrSyntax},
+		{StatusRange, `strconv.ParseInt: parsing "1x": value out of range`, ErrRange},
+		{StatusInvalidBase, `strconv.ParseInt: parsing "1x": invalid base`, nil},
+		{StatusInvalidBitSize, `strconv.ParseInt: parsing "1x": invalid bit size`, nil},
+	}
+	for _, test := range tests {
+		err := test.st.Err("ParseInt", "1x")
+		if err == nil || err.Error() != test.want {
+			t.Errorf("%v.Err = %v, want %s", test.st, err, test.want)
+			continue
+		}
+		if _, ok := err.(*NumError); !ok {
+			t.Errorf("%v.Err is a %T, want *NumError", test.st, err)
+		}
+		if test.is != nil && !errors.Is(err, test.is) {
+			t.Errorf("%v.Err does not match %v", test.st, test.is)
+		}
+	}
+	if err := StatusOK.Err("ParseInt", "1"); err != nil {
+		t.Errorf("StatusOK.Err = %v, want nil", err)
+	}
+	if s := Status(9).String(); s != "Status(9)" {
+		t.Errorf("Status(9).String() = %q", s)
+	}
+}
+
+func TestTryParseAllocs(t *testing.T) {
+	in := []byte("12345678901234567890123x")
+	allocs := testing.AllocsPerRun(100, func() {
+		TryParseInt("-x", 10, 64)
+		TryParseInt("99999999999999999999", 10, 64)
+		TryParseUint("1", 99, 64)
+		TryParseUint("1", 10, 99)
+		TryParseIntBytes(in, 0, 64)
+		TryParseFloat("1.5e", 64)
+		TryParseFloat("1e400", 64)
+		TryParseFloat("0x1p500", 32)
+		TryParseFloatBytes(in, 64)
+		TryParseBool("yes")
+		TryParseBoolBytes(in)
+	})
+	if allocs != 0 {
+		t.Errorf("TryParse functions on invalid inputs: %v allocations, want 0", allocs)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import "unicode/utf8"
+
+// ValidatePrint reports whether s is valid UTF-8 made only of runes for
+// which [IsPrint] holds. If it is not, badOffset is the byte offset of the
+// first rune that is not printable or of the first byte that is not part
+// of valid UTF-8; otherwise badOffset is -1.
+//
+// The two causes can be told apart at badOffset: [utf8.DecodeRuneInString]
+// returns ([utf8.RuneError], 1) there only for invalid UTF-8, since an
+// encoded U+FFFD is printable.
+//
+// Runs of ASCII are checked many bytes at a time; only non-ASCII runes are
+// looked up in the Unicode tables.
+func ValidatePrint(s string) (ok bool, badOffset int) {
+	return validateText(s, false)
+}
+
+// ValidatePrintBytes is like [ValidatePrint] but takes a byte slice.
+func ValidatePrintBytes(b []byte) (ok bool, badOffset int) {
+	return validateText(bytesToString(b), false)
+}
+
+// ValidateGraphic is like [ValidatePrint] but accepts the runes for which
+// [IsGraphic] holds, which include the Unicode space separators.
+func ValidateGraphic(s string) (ok bool, badOffset int) {
+	return validateText(s, true)
+}
+
+// ValidateGraphicBytes is like [ValidateGraphic] but takes a byte slice.
+func ValidateGraphicBytes(b []byte) (ok bool, badOffset int) {
+	return validateText(bytesToString(b), true)
+}
+
+// validateText implements ValidatePrint and, if graphic is set,
+// ValidateGraphic. The two agree on ASCII, where both accept exactly the
+// bytes from ' ' to '~'.
+func validateText(s string, graphic bool) (bool, int) {
+	for i := 0; i < len(s); {
+		if s[i] < utf8.RuneSelf {
+			i += indexNonPrintASCII(s[i:])
+			if i == len(s) {
+				break
+			}
+			if s[i] < utf8.RuneSelf {
+				return false, i
+			}
+		}
+		r, width := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && width == 1 {
+			return false, i
+		}
+		if !IsPrint(r) && !(graphic && isInGraphicList(r)) {
+			return false, i
+		}
+		i += width
+	}
+	return true, -1
+}
+
+// indexNonPrintGeneric returns the index of the first byte of s that is
+// not printable ASCII, or len(s) if there is none. It tests eight bytes at
+// a time for one below ' ' or above '~'.
+func indexNonPrintGeneric(s string) int {
+	const (
+		ones = 0x0101010101010101
+		high = 0x8080808080808080
+	)
+	i := 0
+	for ; i+8 <= len(s); i += 8 {
+		x := readUint64(s, i)
+		if (x-' '*ones)&^x&high != 0 || (x+ones|x)&high != 0 {
+			break
+		}
+	}
+	for ; i < len(s); i++ {
+		if c := s[i]; c < ' ' || c > '~' {
+			return i
+		}
+	}
+	return len(s)
+}
@@ -0,0 +1,25 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64
+
+package fastparse
+
+import "github.com/mshafiee/fastparse/internal/dispatch"
+
+// indexNonPrintAVX2 scans s 32 bytes at a time and returns the index of
+// the first byte that is not printable ASCII, or len(s) if there is none.
+// It requires len(s) >= 32.
+//
+//go:noescape
+func indexNonPrintAVX2(s string) int
+
+// indexNonPrintASCII returns the index of the first byte of s that is not
+// printable ASCII, or len(s) if there is none.
+func indexNonPrintASCII(s string) int {
+	if len(s) >= 32 && dispatch.Allow(dispatch.AVX2) {
+		return indexNonPrintAVX2(s)
+	}
+	return indexNonPrintGeneric(s)
+}
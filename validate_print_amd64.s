@@ -0,0 +1,66 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64
+
+#include "textflag.h"
+
+// func indexNonPrintAVX2(s string) int
+// Adding 0x60 maps ' '..'~' onto -128..-34 as signed bytes and every other
+// byte onto -33..127, so one signed compare finds the printable ones.
+// Requires len(s) >= 32.
+TEXT ·indexNonPrintAVX2(SB), NOSPLIT, $0-24
+	MOVQ s_base+0(FP), SI    // SI = string data pointer
+	MOVQ s_len+8(FP), CX     // CX = string length
+
+	// Y1 = 0x60 repeated 32 times
+	MOVQ $0x60, AX
+	VMOVQ AX, X1
+	VPBROADCASTB X1, Y1
+
+	// Y2 = -33 repeated 32 times
+	MOVQ $0xDF, AX
+	VMOVQ AX, X2
+	VPBROADCASTB X2, Y2
+
+	XORQ DX, DX              // DX = index
+
+loop:
+	LEAQ 32(DX), BX
+	CMPQ BX, CX
+	JHI tail                 // fewer than 32 bytes left
+
+	VMOVDQU (SI)(DX*1), Y0
+	VPADDB Y1, Y0, Y0
+	VPCMPGTB Y0, Y2, Y0      // 0xFF where -33 > byte+0x60: printable
+	VPMOVMSKB Y0, AX
+	XORL $0xFFFFFFFF, AX     // bits set where the byte is not printable
+	JNZ found
+
+	MOVQ BX, DX
+	JMP loop
+
+tail:
+	// Check the last 32 bytes, overlapping bytes already known to be
+	// printable, so any hit is past DX.
+	CMPQ DX, CX
+	JEQ done
+	LEAQ -32(CX), DX
+	VMOVDQU (SI)(DX*1), Y0
+	VPADDB Y1, Y0, Y0
+	VPCMPGTB Y0, Y2, Y0
+	VPMOVMSKB Y0, AX
+	XORL $0xFFFFFFFF, AX
+	JNZ found
+	MOVQ CX, DX
+	JMP done
+
+found:
+	BSFL AX, AX
+	ADDQ AX, DX
+
+done:
+	VZEROUPPER
+	MOVQ DX, ret+16(FP)
+	RET
@@ -0,0 +1,69 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64
+
+#include "textflag.h"
+
+// func indexNonPrintNEON(s string) int
+// A byte is printable when byte-0x20 is below 0x5F, so min(byte-0x20, 0x5F)
+// equals 0x5F exactly for the others. The block holding the first such
+// byte is then searched byte by byte. Requires len(s) >= 16.
+TEXT ·indexNonPrintNEON(SB), NOSPLIT, $0-24
+	MOVD s_base+0(FP), R0        // R0 = string pointer
+	MOVD s_len+8(FP), R1         // R1 = string length
+
+	MOVD $0xE0, R2
+	VDUP R2, V1.B16              // V1 = -0x20 in every lane
+	MOVD $0x5F, R2
+	VDUP R2, V2.B16              // V2 = 0x5F in every lane
+
+	MOVD $0, R3                  // R3 = index
+
+loop:
+	ADD $16, R3, R4
+	CMP R1, R4
+	BHI tail                     // fewer than 16 bytes left
+
+	ADD R0, R3, R5
+	VLD1 (R5), [V0.B16]
+	VADD V1.B16, V0.B16, V0.B16
+	VUMIN V2.B16, V0.B16, V0.B16
+	VCMEQ V2.B16, V0.B16, V0.B16 // 0xFF where the byte is not printable
+	VUMAXV V0.B16, V3
+	VMOV V3.B[0], R6
+	CBNZ R6, scan
+
+	MOVD R4, R3
+	B loop
+
+tail:
+	// Check the last 16 bytes, overlapping bytes already known to be
+	// printable, so any hit is past R3.
+	CMP R1, R3
+	BEQ done
+	SUB $16, R1, R3
+	ADD R0, R3, R5
+	VLD1 (R5), [V0.B16]
+	VADD V1.B16, V0.B16, V0.B16
+	VUMIN V2.B16, V0.B16, V0.B16
+	VCMEQ V2.B16, V0.B16, V0.B16
+	VUMAXV V0.B16, V3
+	VMOV V3.B[0], R6
+	CBNZ R6, scan
+	MOVD R1, R3
+	B done
+
+scan:
+	// The block at R3 holds a byte that is not printable.
+	MOVBU (R0)(R3), R6
+	SUB $0x20, R6, R6
+	CMP $0x5F, R6
+	BHS done
+	ADD $1, R3, R3
+	B scan
+
+done:
+	MOVD R3, ret+16(FP)
+	RET
@@ -0,0 +1,13 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 && !arm64
+
+package fastparse
+
+// indexNonPrintASCII returns the index of the first byte of s that is not
+// printable ASCII, or len(s) if there is none.
+func indexNonPrintASCII(s string) int {
+	return indexNonPrintGeneric(s)
+}
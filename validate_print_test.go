@@ -0,0 +1,130 @@
+// Copyright 2025 Mohammad Shafiee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastparse
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+var validatePrintTests = []struct {
+	in          string
+	graphic     bool
+	ok          bool
+	badOffset   int
+	invalidUTF8 bool
+}{
+	{"", false, true, -1, false},
+	{"Jane Doe", false, true, -1, false},
+	{"tab\there", false, false, 3, false},
+	{"café 世界 \U0001F680", false, true, -1, false},
+	{"no\u00a0break", false, false, 2, false},
+	{"no\u00a0break", true, true, -1, false},
+	{"soft\u00adhyphen", true, false, 4, false},
+	{"zero\u200bwidth", false, false, 4, false},
+	{"bad \xff byte", false, false, 4, true},
+	{"cut \xe4\xb8", true, false, 4, true},
+	{strings.Repeat("x", 70) + "\x7f", false, false, 70, false},
+	{strings.Repeat("x", 70) + "é" + strings.Repeat("y", 40) + "\n", false, false, 112, false},
+	{strings.Repeat("世", 20) + "\x00", false, false, 60, false},
+}
+
+// badUTF8At reports whether the offset returned by ValidatePrint points at
+// invalid UTF-8 rather than an unprintable rune.
+func badUTF8At(s string, off int) bool {
+	if off < 0 {
+		return false
+	}
+	r, width := utf8.DecodeRuneInString(s[off:])
+	return r == utf8.RuneError && width == 1
+}
+
+func TestValidatePrint(t *testing.T) {
+	for _, tt := range validatePrintTests {
+		name, validate, validateBytes := "ValidatePrint", ValidatePrint, ValidatePrintBytes
+		if tt.graphic {
+			name, validate, validateBytes = "ValidateGraphic", ValidateGraphic, ValidateGraphicBytes
+		}
+		ok, off := validate(tt.in)
+		bad := badUTF8At(tt.in, off)
+		if ok != tt.ok || off != tt.badOffset || bad != tt.invalidUTF8 {
+			t.Errorf("%s(%q) = %t, %d, %t, want %t, %d, %t", name, tt.in, ok, off, bad, tt.ok, tt.badOffset, tt.invalidUTF8)
+		}
+		ok, off = validateBytes([]byte(tt.in))
+		bad = badUTF8At(tt.in, off)
+		if ok != tt.ok || off != tt.badOffset || bad != tt.invalidUTF8 {
+			t.Errorf("%sBytes(%q) = %t, %d, %t, want %t, %d, %t", name, tt.in, ok, off, bad, tt.ok, tt.badOffset, tt.invalidUTF8)
+		}
+	}
+}
+
+// validateRunes is the rune-at-a-time loop that ValidatePrint replaces.
+func validateRunes(s string, graphic bool) (bool, int) {
+	for i, r := range s {
+		if !IsPrint(r) && !(graphic && IsGraphic(r)) || badUTF8At(s, i) {
+			return false, i
+		}
+	}
+	return true, -1
+}
+
+func TestValidatePrintRandom(t *testing.T) {
+	pieces := []string{
+		"a", "Hello, World! ", strings.Repeat("0123456789", 5), " ", "\t", "\x00", "\x7f", "~",
+		"é", "\u00a0", "\u00ad", "世", "\u3000", "\U0001F680", "\U000E0001", "\ufffd", "\xff", "\xe4\xb8",
+	}
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 20000; n++ {
+		var b strings.Builder
+		for k := r.Intn(12); k > 0; k-- {
+			b.WriteString(pieces[r.Intn(len(pieces))])
+		}
+		s := b.String()
+		for _, graphic := range []bool{false, true} {
+			ok, off := validateText(s, graphic)
+			wok, woff := validateRunes(s, graphic)
+			if ok != wok || off != woff {
+				t.Fatalf("validateText(%q, %t) = %t, %d, want %t, %d", s, graphic, ok, off, wok, woff)
+			}
+		}
+	}
+}
+
+// TestIndexNonPrintASCII checks the SIMD scan at every length and position
+// against the portable one.
+func TestIndexNonPrintASCII(t *testing.T) {
+	buf := []byte(strings.Repeat("The quick brown fox jumps over the lazy dog. ", 4))
+	for _, c := range []byte{0x00, 0x1f, 0x7f, 0x80, 0xff} {
+		for n := 0; n <= len(buf); n++ {
+			for pos := 0; pos <= n; pos++ {
+				b := append([]byte(nil), buf[:n]...)
+				want := n
+				if pos < n {
+					b[pos] = c
+					want = pos
+				}
+				if got := indexNonPrintASCII(string(b)); got != want {
+					t.Fatalf("indexNonPrintASCII(%q) = %d, want %d", b, got, want)
+				}
+				if got := indexNonPrintGeneric(string(b)); got != want {
+					t.Fatalf("indexNonPrintGeneric(%q) = %d, want %d", b, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestValidatePrintAllocs(t *testing.T) {
+	s := strings.Repeat("display name é ", 8)
+	b := []byte(s)
+	if n := testing.AllocsPerRun(100, func() {
+		ValidatePrint(s)
+		ValidateGraphicBytes(b)
+	}); n != 0 {
+		t.Errorf("ValidatePrint allocates %v times", n)
+	}
+}